@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/env"
+	"github.com/lbryio/commentron/server/apikeys"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var apiKeyScopes []string
+var apiKeyRateLimit int
+
+func init() {
+	apiKeyCreateCmd.Flags().StringSliceVar(&apiKeyScopes, "scopes", nil, "scopes of the key, any of "+strings.Join(apikeys.Scopes, ", "))
+	apiKeyCreateCmd.Flags().IntVar(&apiKeyRateLimit, "rate-limit", 600, "requests per minute allowed with the key")
+	apiKeyCmd.AddCommand(apiKeyCreateCmd)
+	apiKeyCmd.AddCommand(apiKeyRevokeCmd)
+	rootCmd.AddCommand(apiKeyCmd)
+}
+
+var apiKeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "Manages the api keys of trusted applications",
+	Long:  `Manages the api keys trusted applications use to call the apis of their scopes without a channel signature`,
+}
+
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Creates an api key",
+	Long:  `Creates an api key with the scopes and prints it, it cannot be shown again`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		key, err := apikeys.Create(args[0], apiKeyScopes, apiKeyRateLimit)
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+		fmt.Println(key)
+	},
+}
+
+var apiKeyRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Revokes an api key",
+	Long:  `Revokes an api key, servers stop accepting it within a minute`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		err := apikeys.Revoke(args[0])
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+	},
+}
+
+func initConfig() {
+	conf, err := env.NewWithEnvVars()
+	if err != nil {
+		logrus.Panic(err)
+	}
+	config.InitializeConfiguration(conf)
+}
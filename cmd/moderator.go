@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/server/moderators"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var moderatorLevel int64
+var moderatorReason string
+
+func init() {
+	moderatorAddCmd.Flags().Int64Var(&moderatorLevel, "level", commentapi.ModLevelBlock, "1 blocks universally, 2 also deletes all comments of a channel, 3 also manages moderators and spam lists")
+	moderatorAddCmd.Flags().StringVar(&moderatorReason, "reason", "", "reason kept in the moderation audit log")
+	moderatorRemoveCmd.Flags().StringVar(&moderatorReason, "reason", "", "reason kept in the moderation audit log")
+	moderatorCmd.AddCommand(moderatorAddCmd)
+	moderatorCmd.AddCommand(moderatorRemoveCmd)
+	moderatorCmd.AddCommand(moderatorListCmd)
+	rootCmd.AddCommand(moderatorCmd)
+}
+
+var moderatorCmd = &cobra.Command{
+	Use:   "moderator",
+	Short: "Manages the global moderators",
+	Long:  `Manages the global moderators of the comment server and their levels`,
+}
+
+var moderatorAddCmd = &cobra.Command{
+	Use:   "add <channel_id> <channel_name>",
+	Short: "Adds a global moderator",
+	Long:  `Makes the channel a global moderator of the level, or changes the level of an existing one`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		channel, err := helper.FindOrCreateChannel(args[0], args[1])
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+		err = moderators.Set(channel, moderatorLevel, "", "", moderatorReason)
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+	},
+}
+
+var moderatorRemoveCmd = &cobra.Command{
+	Use:   "remove <channel_id>",
+	Short: "Removes a global moderator",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		err := moderators.Remove(args[0], "", "", moderatorReason)
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+	},
+}
+
+var moderatorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the global moderators",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		list, err := moderators.List()
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+		for _, m := range list {
+			fmt.Printf("%s\t%s\t%d\n", m.ChannelID, m.ChannelName, m.Level)
+		}
+	},
+}
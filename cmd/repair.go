@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/env"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(repairCountersCmd)
+	rootCmd.AddCommand(backfillCreatorsCmd)
+}
+
+var repairCountersCmd = &cobra.Command{
+	Use:   "repair-counters",
+	Short: "Recomputes the reply and reaction counters of all comments",
+	Long:  `Recomputes the reply and reaction counters of all comments from the replies and reactions stored`,
+	Args:  cobra.OnlyValidArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := env.NewWithEnvVars()
+		if err != nil {
+			logrus.Panic(err)
+		}
+		config.InitializeConfiguration(conf)
+		err = helper.RepairCounters()
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+	},
+}
+
+var backfillCreatorsCmd = &cobra.Command{
+	Use:   "backfill-creators",
+	Short: "Sets the creator channel and creator likes of comments made before they were stored",
+	Long:  `Resolves the channel that signed the claim of every comment without a creator channel and stores it with the comment, along with whether the creator liked it`,
+	Args:  cobra.OnlyValidArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := env.NewWithEnvVars()
+		if err != nil {
+			logrus.Panic(err)
+		}
+		if conf.SDKUrl == "" {
+			logrus.Fatal("the claims can only be resolved with an sdk url")
+		}
+		config.InitializeConfiguration(conf)
+		lbry.Init(conf)
+		err = lbry.BackfillCreators()
+		if err != nil {
+			logrus.Fatal(errors.FullTrace(err))
+		}
+	},
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/lbryio/commentron/config"
 	"github.com/lbryio/commentron/env"
 	"github.com/lbryio/commentron/server"
+	"github.com/lbryio/commentron/server/jobs"
 	"github.com/lbryio/commentron/server/lbry"
 	"github.com/pkg/profile"
 	"github.com/sirupsen/logrus"
@@ -14,6 +15,7 @@ import (
 func init() {
 	serveCmd.PersistentFlags().StringVarP(&server.RPCHost, "host", "", "", "host to listen on")
 	serveCmd.PersistentFlags().IntVarP(&server.RPCPort, "port", "p", 5900, "port binding used for the rpc server")
+	serveCmd.PersistentFlags().BoolVar(&jobs.RunSharedJobs, "shared-jobs", true, "runs the background jobs only one instance should run, like tip verification and webhook delivery. When running several instances pass --shared-jobs=false to all but one of them")
 	serveCmd.PersistentFlags().BoolVar(&lbry.ValidateSignatures, "validate", true, "allows the server to avoid validating signatures. good for local testing")
 	//Bind to Viper
 	err := viper.BindPFlags(serveCmd.PersistentFlags())
@@ -0,0 +1,109 @@
+package commentapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// Moderation actions recorded in the audit log returned by moderation.AuditLog
+const (
+	ActionBlock              = "block"
+	ActionUnblock            = "unblock"
+	ActionAddDelegate        = "add_delegate"
+	ActionRemoveDelegate     = "remove_delegate"
+	ActionUpdateDelegate     = "update_delegate"
+	ActionAcceptDelegate     = "accept_delegate"
+	ActionDeclineDelegate    = "decline_delegate"
+	ActionResignDelegate     = "resign_delegate"
+	ActionPin                = "pin"
+	ActionUnpin              = "unpin"
+	ActionDelete             = "delete"
+	ActionPurge              = "purge"
+	ActionBlockWords         = "block_words"
+	ActionUnblockWords       = "unblock_words"
+	ActionUpdateSettings     = "update_settings"
+	ActionManageReactionType = "manage_reaction_type"
+	ActionAddModerator       = "add_moderator"
+	ActionRemoveModerator    = "remove_moderator"
+	ActionManageSpammer      = "manage_spammer"
+)
+
+// AuditLogArgs arguments for the moderation.AuditLog rpc call. Creators see the actions of themselves and their
+// delegates on their content, global moderators see every action or those for a single creator.
+type AuditLogArgs struct {
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	// The creator to see the actions of, defaults to the mod channel. Only global moderators can pass other creators.
+	CreatorChannelID   string `json:"creator_channel_id"`
+	CreatorChannelName string `json:"creator_channel_name"`
+	// Filters the actions to those done by this channel
+	ActorChannelID *string `json:"actor_channel_id"`
+	// Filters the actions to those of this kind, ie block
+	Action    *string `json:"action"`
+	Page      int     `json:"page"`
+	PageSize  int     `json:"page_size"`
+	Signature string  `json:"signature"`
+	SigningTS string  `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (a AuditLogArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&a,
+		v.Field(&a.ModChannelID, validator.ClaimID, v.Required),
+		v.Field(&a.ModChannelName, v.Required),
+		v.Field(&a.CreatorChannelID, validator.ClaimID),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ApplyDefaults applies the default values for arguments passed that are different from normal defaults.
+func (a *AuditLogArgs) ApplyDefaults() {
+	if a.Page <= 0 {
+		a.Page = 1
+	}
+	if a.PageSize <= 0 {
+		a.PageSize = 50
+	}
+	if a.PageSize > 200 {
+		a.PageSize = 200
+	}
+}
+
+// ModerationAction is an entry of the audit log, newest first
+type ModerationAction struct {
+	ID               uint64 `json:"id"`
+	Action           string `json:"action"`
+	ActorChannelID   string `json:"actor_channel_id"`
+	ActorChannelName string `json:"actor_channel_name"`
+	// Not set for global actions like universal blocks
+	CreatorChannelID string `json:"creator_channel_id,omitempty"`
+	TargetChannelID  string `json:"target_channel_id,omitempty"`
+	ClaimID          string `json:"claim_id,omitempty"`
+	CommentID        string `json:"comment_id,omitempty"`
+	// What the action changed, the shape depends on the action
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+	Reason string          `json:"reason,omitempty"`
+	// The name of the api key a trusted application made the action with on behalf of the actor, who did not sign it
+	APIKey    string    `json:"api_key,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditLogResponse response for the moderation.AuditLog rpc call
+type AuditLogResponse struct {
+	Actions    []ModerationAction `json:"actions"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"page_size"`
+	TotalPages int                `json:"total_pages"`
+	TotalItems int64              `json:"total_items"`
+}
@@ -1,9 +1,18 @@
 package commentapi
 
-// Authorization parameters for calls requiring user authentication
+// Authorization parameters for calls requiring user authentication. The channel signs the method digest of the call,
+// see helper.MethodDigest, with a signing_ts close to the server time. Calls that change something cannot reuse a
+// signature.
 type Authorization struct {
 	ChannelName string `json:"channel_name"`
 	ChannelID   string `json:"channel_id"`
 	Signature   string `json:"signature"`
 	SigningTS   string `json:"signing_ts"`
 }
+
+// Delegation parameters for calls a delegated moderator can make for the creator channel of the Authorization. When
+// passed the moderator signs the call instead of the creator, and needs the Permission* of the call.
+type Delegation struct {
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+}
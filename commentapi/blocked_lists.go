@@ -12,7 +12,8 @@ import (
 	v "github.com/lbryio/ozzo-validation"
 )
 
-// SharedBlockedListUpdateArgs use for blockedlist.Update api
+// SharedBlockedListUpdateArgs use for blockedlist.Update api. The fields passed are signed after the channel id as one
+// JSON object, the same way as the UpdateSettingsArgs.
 type SharedBlockedListUpdateArgs struct {
 	Authorization
 	SharedBlockedList
@@ -56,6 +57,8 @@ type SharedBlockedList struct {
 // SharedBlockedListInviteArgs arguments for blocklist.Invite
 type SharedBlockedListInviteArgs struct {
 	Authorization
+	// Delegates need PermissionInvite
+	Delegation
 
 	SharedBlockedListID uint64 `json:"blocked_list_id"`
 	InviteeChannelName  string `json:"invitee_channel_name"`
@@ -0,0 +1,65 @@
+package commentapi
+
+import "encoding/json"
+
+// Kinds of changes in the change log returned by comment.Changes
+const (
+	ChangeCreate   = "create"
+	ChangeEdit     = "edit"
+	ChangeDelete   = "delete"
+	ChangeHide     = "hide"
+	ChangeReaction = "reaction"
+	ChangeBlock    = "block"
+	ChangeUnblock  = "unblock"
+)
+
+// ChangesArgs arguments for the comment.Changes rpc call, which requires an api key with the changes scope passed as a
+// bearer token in the Authorization header of /api/v2. Changes
+// after a gap in the seqs are held back for up to a minute so that changes committed out of order are not skipped by
+// consumers. A change committed more than a minute after it was made can still be skipped, consumers that cannot miss
+// any change should regularly resync by reading again from an earlier seq, the change log is kept.
+type ChangesArgs struct {
+	// Seq of the last change already seen, 0 to start from the beginning
+	Since uint64 `json:"since"`
+	Limit int    `json:"limit"`
+}
+
+// ApplyDefaults applies the default values for arguments passed that are different from normal defaults.
+func (c *ChangesArgs) ApplyDefaults() {
+	if c.Limit <= 0 {
+		c.Limit = 500
+	}
+	if c.Limit > 1000 {
+		c.Limit = 1000
+	}
+}
+
+// Change is an entry of the change log, ordered by its seq
+type Change struct {
+	Seq  uint64 `json:"seq"`
+	Kind string `json:"kind"`
+	// Not set for blocks
+	CommentID string `json:"comment_id,omitempty"`
+	ClaimID   string `json:"claim_id,omitempty"`
+	// The author of the comment, the channel that reacted for reactions and the blocked channel for blocks
+	ChannelID string `json:"channel_id,omitempty"`
+	// Unix timestamp of the change
+	Timestamp int64 `json:"timestamp"`
+	// A CommentItem for creates, edits and deletes, a ReactionChange for reactions and a BlockEvent for blocks
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// ReactionChange is the data of a reaction change, delta is 1 when the reaction was added and -1 when removed
+type ReactionChange struct {
+	ReactionType string `json:"reaction_type"`
+	Delta        int    `json:"delta"`
+}
+
+// ChangesResponse response for the comment.Changes rpc call
+type ChangesResponse struct {
+	Changes []Change `json:"changes"`
+	// Seq to pass as since to get the next batch
+	Next uint64 `json:"next"`
+	// Set when there are more changes already available after this batch
+	HasMore bool `json:"has_more"`
+}
@@ -52,13 +52,21 @@ func (d *Client) Sign(args interface{}) interface{} {
 }
 
 // WithSigning allows for a client to be used with identity priviledges handling the signing of APIs requiring
-// user authorization. It requires the channel export string `./lbrynet channel export <channel_id>` as well as
-// an authorized apiKey from the comment server owner.
+// user authorization. It requires the channel export string `./lbrynet channel export <channel_id>`.
 func (d *Client) WithSigning(export string) *Client {
 	d.Channel = lbry.ImportChannel(export)
 	return d
 }
 
+// WithAPIKey passes an api key created by the comment server owner with every call, giving access to the apis of its
+// scopes without a channel signature. Api keys are only accepted by /api/v2.
+func (d *Client) WithAPIKey(apiKey string) *Client {
+	d.conn = jsonrpc.NewClientWithOpts(d.address, &jsonrpc.RPCClientOpts{
+		CustomHeaders: map[string]string{"Authorization": "Bearer " + apiKey},
+	})
+	return d
+}
+
 ///////////////////////
 //  REACTION SERVICE //
 ///////////////////////
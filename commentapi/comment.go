@@ -30,8 +30,28 @@ type CommentItem struct {
 	IsHidden      bool    `json:"is_hidden"`
 	IsPinned      bool    `json:"is_pinned"`
 	IsFiat        bool    `json:"is_fiat"`
+	PinnedUntil   uint64  `json:"pinned_until,omitempty"`
+	PaymentStatus string  `json:"payment_status,omitempty"`
+	// CreatorLiked is set when the creator of the claim liked the comment, CreatorLikedBy is their channel
+	CreatorLiked   bool   `json:"creator_liked,omitempty"`
+	CreatorLikedBy string `json:"creator_liked_by,omitempty"`
+	// Only set by comment.List when asked for with its include flag or viewer channel
+	Reactions   CommentReaction `json:"reactions,omitempty"`
+	MyReactions CommentReaction `json:"my_reactions,omitempty"`
 }
 
+// Payment statuses of a hyperchat
+const (
+	PaymentSucceeded         = "succeeded"
+	PaymentPartiallyRefunded = "partially_refunded"
+	PaymentRefunded          = "refunded"
+	PaymentDisputed          = "disputed"
+	// LBC tips are pending until they have enough confirmations and reversed if the support is abandoned or dropped
+	PaymentPending   = "pending"
+	PaymentConfirmed = "confirmed"
+	PaymentReversed  = "reversed"
+)
+
 // ChannelArgs arguments to the comment.GetChannelForCommentID call
 type ChannelArgs struct {
 	CommentID string `json:"comment_id"`
@@ -88,14 +108,18 @@ type ByIDResponse struct {
 	Ancestors []CommentItem `json:"ancestors,omitempty"`
 }
 
-// PinArgs arguments for the comment.Pin rpc call. The comment id must be signed with a timestamp for authentication.
+// PinArgs arguments for the comment.Pin rpc call. The comment id and remove must be signed with a timestamp for
+// authentication.
 type PinArgs struct {
 	CommentID   string `json:"comment_id"`
 	ChannelID   string `json:"channel_id"`
 	ChannelName string `json:"channel_name"` //Technical debt? probably dont need this since we can get the channel from the comment claim
 	Remove      bool   `json:"remove"`
-	Signature   string `json:"signature"`
-	SigningTS   string `json:"signing_ts"`
+	// Delegated moderator of the creator signing instead of them, needs PermissionPin
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	Signature      string `json:"signature"`
+	SigningTS      string `json:"signing_ts"`
 }
 
 // PinResponse response for the comment.Pin rpc call
@@ -115,6 +139,10 @@ const (
 	Controversy
 	// Popularity sorts the comments by how popular it is
 	Popularity
+	// Hot sorts the comments by their net likes decayed by their age
+	Hot
+	// Best sorts the comments by the lower bound of their share of likes, favoring comments with more reactions
+	Best
 )
 
 // ListArgs arguments for the comment.List rpc call
@@ -128,7 +156,16 @@ type ListArgs struct {
 	PageSize      int     `json:"page_size"`       // pagination: nr of comments to show in a page (max 200)
 	TopLevel      bool    `json:"top_level"`       // filters to only top level comments
 	Hidden        bool    `json:"hidden"`          // if true will show hidden comments as well
-	SortBy        Sort    `json:"sort_by"`         // can be popularity, controversy, default is time (newest)
+	SortBy        Sort    `json:"sort_by"`         // can be popularity, controversy, hot, best, default is time (newest)
+	// CreatorLikedFirst puts the comments liked by the creator right after the pinned ones
+	CreatorLikedFirst bool `json:"creator_liked_first"`
+
+	IncludeReactions bool `json:"include_reactions"` // adds the reaction counts to each comment
+	// adds the reactions of the viewer to each comment, the viewer channel name must be signed
+	ViewerChannelID   *string `json:"viewer_channel_id"`
+	ViewerChannelName *string `json:"viewer_channel_name"`
+	Signature         string  `json:"signature"`
+	SigningTS         string  `json:"signing_ts"`
 }
 
 // AbandonArgs are the arguments passed to comment.Abandon RPC call. If creator args are passed
@@ -140,6 +177,11 @@ type AbandonArgs struct {
 	SigningTS          string  `json:"signing_ts"`
 	CreatorChannelID   *string `json:"creator_channel_id"`
 	CreatorChannelName *string `json:"creator_channel_name"`
+	// Delegated moderator of the creator signing instead of them, needs PermissionDelete
+	ModChannelID   *string `json:"mod_channel_id"`
+	ModChannelName *string `json:"mod_channel_name"`
+	// Why the creator or a moderator deletes the comment, kept in the moderation audit log
+	Reason string `json:"reason"`
 }
 
 // AbandonResponse the response to the abandon call
@@ -0,0 +1,57 @@
+package commentapi
+
+import (
+	"net/http"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// LocateArgs arguments for the comment.Locate rpc call. The sort and page size should match the comment.List calls
+// the pages will be loaded with.
+type LocateArgs struct {
+	CommentID   string  `json:"comment_id"`
+	ChannelName *string `json:"channel_name"` // signing channel name of claim
+	ChannelID   *string `json:"channel_id"`   // signing channel claim id of claim
+	PageSize    int     `json:"page_size"`
+	SortBy      Sort    `json:"sort_by"`
+
+	CreatorLikedFirst bool `json:"creator_liked_first"`
+}
+
+// Validate validates the data in the args
+func (l LocateArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&l,
+		v.Field(&l.CommentID, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ApplyDefaults applies the default values for arguments passed that are different from normal defaults.
+func (l *LocateArgs) ApplyDefaults() {
+	if l.PageSize == 0 {
+		l.PageSize = 50
+	}
+	if l.PageSize > 600 {
+		l.PageSize = 600
+	}
+}
+
+// LocateResponse response for the comment.Locate rpc call. Pages are 1 based like the page argument of comment.List.
+type LocateResponse struct {
+	CommentID string `json:"comment_id"`
+	ClaimID   string `json:"claim_id"`
+	// The top level comment of the thread, the comment itself if it is top level
+	TopLevelCommentID string `json:"top_level_comment_id"`
+	// Page of the top level comment when listing the top level comments of the claim
+	TopLevelPage int `json:"top_level_page"`
+	// Parent of the comment and page of the comment when listing the replies of the parent, not set for top level comments
+	ParentID  *string `json:"parent_id,omitempty"`
+	ReplyPage int     `json:"reply_page,omitempty"`
+	PageSize  int     `json:"page_size"`
+	SortBy    Sort    `json:"sort_by"`
+}
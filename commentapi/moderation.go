@@ -2,6 +2,41 @@ package commentapi
 
 import "time"
 
+// Permissions of a delegated moderator, a delegate can take the actions of the bits set for them by the creator.
+const (
+	// PermissionBlock allows blocking and unblocking channels from commenting on the creator's content, permanently or
+	// not. It includes PermissionTimeout.
+	PermissionBlock uint64 = 1 << iota
+	// PermissionTimeout allows blocks that expire, without PermissionBlock a delegate cannot block permanently or unblock
+	PermissionTimeout
+	// PermissionDelete allows deleting comments on the creator's content
+	PermissionDelete
+	// PermissionPin allows pinning and unpinning comments on the creator's content
+	PermissionPin
+	// PermissionHide allows hiding comments on the creator's content, ie moderation.Purge
+	PermissionHide
+	// PermissionMutedWords allows editing the blocked words of the creator
+	PermissionMutedWords
+	// PermissionSettings allows changing the creator settings
+	PermissionSettings
+	// PermissionInvite allows inviting channels to the shared blocked list of the creator
+	PermissionInvite
+
+	// PermissionAll are all the permissions, the default for new delegates
+	PermissionAll = PermissionInvite<<1 - 1
+)
+
+// Statuses of delegated moderators
+const (
+	// DelegateStatusPending is a delegate invited by the creator that did not accept yet
+	DelegateStatusPending = "pending"
+	// DelegateStatusActive is a delegate that accepted the invite of the creator
+	DelegateStatusActive = "active"
+	// DelegateStatusExpired is a delegate that did not accept the invite of the creator in time, or whose expiry passed.
+	// The creator can invite it again.
+	DelegateStatusExpired = "expired"
+)
+
 // BlockArgs Arguments to block identities from commenting for both publisher and moderators
 type BlockArgs struct {
 	//Publisher, Moderator, or Commentron Admin
@@ -15,10 +50,16 @@ type BlockArgs struct {
 	CreatorChannelName string `json:"creator_channel_name"`
 	// Blocks identity from comment universally, requires Admin rights on commentron instance
 	BlockAll bool `json:"block_all"`
-	// Measured in seconds for the amount of time a channel is blocked for.
+	// Measured in seconds for the amount of time a channel is blocked for. Delegates with PermissionTimeout but not
+	// PermissionBlock must pass it.
 	TimeOut uint64 `json:"time_out"`
 	// If true will delete all comments of the offender, requires Admin rights on commentron for universal delete
-	DeleteAll bool   `json:"delete_all"`
+	DeleteAll bool `json:"delete_all"`
+	// The claim the offender is blocked for, kept in the moderation audit log. The block covers all claims of the
+	// creator, so delegates scoped to some claims cannot block. When passed it is signed after delete_all.
+	ClaimID string `json:"claim_id"`
+	// Why the channel is blocked, kept in the moderation audit log
+	Reason    string `json:"reason"`
 	Signature string `json:"signature"`
 	SigningTS string `json:"signing_ts"`
 }
@@ -46,6 +87,15 @@ type AmIResponse struct {
 	ChannelID          string            `json:"channel_id"`
 	Type               string            `json:"type"`
 	AuthorizedChannels map[string]string `json:"authorized_channels"`
+	// The permissions the channel has as a delegate, by the claim id of the creator
+	Permissions map[string]uint64 `json:"permissions"`
+	// One of the ModLevel* for global moderators
+	ModLevel int64 `json:"mod_level,omitempty"`
+	// The creators with a pending invite for the channel to moderate for them, see moderation.AcceptDelegate
+	Invites map[string]string `json:"invites"`
+	// The claims the channel can moderate for creators that scoped it to some of their claims, by the claim id of the
+	// creator
+	ClaimScopes map[string][]string `json:"claim_scopes"`
 }
 
 // UnBlockArgs Arguments to un-block identities from commenting for both publisher and moderators
@@ -60,9 +110,14 @@ type UnBlockArgs struct {
 	CreatorChannelID   string `json:"creator_channel_id"`
 	CreatorChannelName string `json:"creator_channel_name"`
 	// Unblocks identity from commenting universally, requires Admin rights on commentron instance
-	GlobalUnBlock bool   `json:"global_un_block"`
-	Signature     string `json:"signature"`
-	SigningTS     string `json:"signing_ts"`
+	GlobalUnBlock bool `json:"global_un_block"`
+	// The claim the offender was blocked for, kept in the moderation audit log. Delegates scoped to some claims cannot
+	// unblock. When passed it is signed after global_un_block.
+	ClaimID string `json:"claim_id"`
+	// Why the channel is unblocked, kept in the moderation audit log
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
 }
 
 // UnBlockResponse for the moderation.UnBlock rpc call
@@ -104,14 +159,41 @@ type BlockedChannel struct {
 	BlcokRemaining       time.Duration `json:"ban_remaining"`
 }
 
-// AddDelegateArgs Arguments to delagate moderation to another channel for your channel.
+// AddDelegateArgs Arguments to delagate moderation to another channel for your channel. The channel is invited and
+// becomes a moderator once it accepts, see moderation.AcceptDelegate.
 type AddDelegateArgs struct {
 	ModChannelID       string `json:"mod_channel_id"`
 	ModChannelName     string `json:"mod_channel_name"`
 	CreatorChannelID   string `json:"creator_channel_id"`
 	CreatorChannelName string `json:"creator_channel_name"`
-	Signature          string `json:"signature"`
-	SigningTS          string `json:"signing_ts"`
+	// Bitmask of the Permission* the delegate gets, defaults to PermissionAll. When passed it is signed after the mod
+	// channel id.
+	Permissions *uint64 `json:"permissions"`
+	// Claims the delegate may moderate, all claims of the creator when empty. Delegates scoped to some claims cannot
+	// block or unblock, as blocks cover all the claims of the creator. When claim ids or an expiry are passed
+	// the permissions, the comma separated claim ids and the expiry are signed after the mod channel id.
+	ClaimIDs []string `json:"claim_ids"`
+	// Unix time the delegate stops being a moderator, never when not passed
+	ExpiresAt *int64 `json:"expires_at"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// UpdateDelegateArgs Arguments to change the permissions, claim scope and expiry of a delegated moderator.
+type UpdateDelegateArgs struct {
+	ModChannelID       string `json:"mod_channel_id"`
+	ModChannelName     string `json:"mod_channel_name"`
+	CreatorChannelID   string `json:"creator_channel_id"`
+	CreatorChannelName string `json:"creator_channel_name"`
+	// Bitmask of the Permission* the delegate has from now on
+	Permissions uint64 `json:"permissions"`
+	// Replaces the claims the delegate may moderate, all claims of the creator when empty. When claim ids or an expiry
+	// are passed the comma separated claim ids and the expiry are signed after the permissions.
+	ClaimIDs []string `json:"claim_ids"`
+	// Replaces the unix time the delegate stops being a moderator, never when not passed
+	ExpiresAt *int64 `json:"expires_at"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
 }
 
 // RemoveDelegateArgs Arguments to remove a delegated moderator.
@@ -124,6 +206,17 @@ type RemoveDelegateArgs struct {
 	SigningTS          string `json:"signing_ts"`
 }
 
+// DelegateInviteArgs Arguments for moderation.AcceptDelegate, DeclineDelegate and ResignDelegate, signed by the mod
+// channel invited by or moderating for the creator.
+type DelegateInviteArgs struct {
+	ModChannelID       string `json:"mod_channel_id"`
+	ModChannelName     string `json:"mod_channel_name"`
+	CreatorChannelID   string `json:"creator_channel_id"`
+	CreatorChannelName string `json:"creator_channel_name"`
+	Signature          string `json:"signature"`
+	SigningTS          string `json:"signing_ts"`
+}
+
 // ListDelegatesArgs Arguments to list delegates
 type ListDelegatesArgs struct {
 	CreatorChannelID   string `json:"creator_channel_id"`
@@ -141,4 +234,38 @@ type ListDelegateResponse struct {
 type Delegate struct {
 	ChannelID   string `json:"channel_id"`
 	ChannelName string `json:"channel_name"`
+	Permissions uint64 `json:"permissions"`
+	// One of the DelegateStatus*
+	Status string `json:"status"`
+	// When the invite of a pending delegate expires
+	InviteExpiresAt *time.Time `json:"invite_expires_at,omitempty"`
+	// Claims the delegate may moderate, all claims when empty
+	ClaimIDs []string `json:"claim_ids,omitempty"`
+	// When the delegate stops being a moderator
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// PurgeArgs Arguments to clear the chat of a claim or remove the recent comments of a single channel on it.
+type PurgeArgs struct {
+	//Publisher, Moderator or Commentron Admin
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	//Creator that Moderator is delegated from. Used for delegated moderation
+	CreatorChannelID   string `json:"creator_channel_id"`
+	CreatorChannelName string `json:"creator_channel_name"`
+	// The claim to purge comments from, it must be signed by the creator
+	ClaimID string `json:"claim_id"`
+	// If passed only the comments of this channel are purged
+	PurgedChannelID *string `json:"purged_channel_id"`
+	// Measured in seconds for how far back comments are purged. If 0 all comments on the claim are purged.
+	Window uint64 `json:"window"`
+	// Why the comments are purged, kept in the moderation audit log
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// PurgeResponse for the moderation.Purge rpc call
+type PurgeResponse struct {
+	PurgedCommentIDs []string `json:"purged_comment_ids"`
 }
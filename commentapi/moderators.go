@@ -0,0 +1,87 @@
+package commentapi
+
+import "time"
+
+// Levels of global moderators, each level can also do what the levels below it can
+const (
+	// ModLevelBlock can block channels universally
+	ModLevelBlock int64 = 1
+	// ModLevelDeleteAll can delete all the comments of a channel when blocking it
+	ModLevelDeleteAll int64 = 2
+	// ModLevelAdmin can manage the other global moderators and the spam lists
+	ModLevelAdmin int64 = 3
+)
+
+// AddModeratorArgs arguments for the moderation.AddModerator rpc call. It is made with an api key that has the
+// moderators scope or signed by a global moderator of ModLevelAdmin. Adding an existing moderator changes their level.
+type AddModeratorArgs struct {
+	// The global moderator signing the call, not needed with an api key
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	// The channel to make a global moderator
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	// One of the ModLevel*, defaults to ModLevelBlock
+	Level     int64  `json:"level"`
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// RemoveModeratorArgs arguments for the moderation.RemoveModerator rpc call. It is made with an api key that has the
+// moderators scope or signed by a global moderator of ModLevelAdmin.
+type RemoveModeratorArgs struct {
+	// The global moderator signing the call, not needed with an api key
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	// The global moderator to remove
+	ChannelID string `json:"channel_id"`
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// ListModeratorsArgs arguments for the moderation.ListModerators rpc call. It is made with an api key that has the
+// moderators scope or signed by any global moderator.
+type ListModeratorsArgs struct {
+	// The global moderator signing the call, not needed with an api key
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	Signature      string `json:"signature"`
+	SigningTS      string `json:"signing_ts"`
+}
+
+// ModeratorsResponse response for the moderation.AddModerator, RemoveModerator and ListModerators rpc calls
+type ModeratorsResponse struct {
+	Moderators []GlobalModerator `json:"moderators"`
+}
+
+// GlobalModerator is a channel that moderates all content of the comment server
+type GlobalModerator struct {
+	ChannelID   string    `json:"channel_id"`
+	ChannelName string    `json:"channel_name"`
+	Level       int64     `json:"level"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ManageSpammerArgs arguments for the moderation.ManageSpammer rpc call, signed by a global moderator of ModLevelAdmin.
+// Adds the channel to a spam list or removes it, the comments or reactions of channels on them are flagged.
+type ManageSpammerArgs struct {
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	ChannelID      string `json:"channel_id"`
+	// The spam list, comment or reaction
+	Kind      string `json:"kind"`
+	Remove    bool   `json:"remove"`
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// ManageSpammerResponse response for the moderation.ManageSpammer rpc call
+type ManageSpammerResponse struct {
+	ChannelID string `json:"channel_id"`
+	Kind      string `json:"kind"`
+	// Whether the channel is on the spam list now
+	Spammer bool `json:"spammer"`
+}
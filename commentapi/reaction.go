@@ -1,7 +1,18 @@
 package commentapi
 
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
 // ReactArgs are the arguments passed to comment.Abandon RPC call
 type ReactArgs struct {
+	// Comma separated, up to MaxReactCommentIDs comments are reacted to all at once or not at all
 	CommentIDs  string `json:"comment_ids"`
 	Signature   string `json:"signature"`
 	SigningTS   string `json:"signing_ts"`
@@ -12,11 +23,23 @@ type ReactArgs struct {
 	ChannelName string `json:"channel_name"`
 }
 
+// MaxReactCommentIDs is the most comments that can be reacted to in one reaction.React call
+const MaxReactCommentIDs = 50
+
 // ReactResponse the response to the abandon call
 type ReactResponse struct {
 	Reactions
+	// What happened to each comment, keyed by comment id
+	Results map[string]string `json:"results,omitempty"`
 }
 
+// Results of reacting to a comment
+const (
+	ReactionAdded     = "added"
+	ReactionRemoved   = "removed"
+	ReactionUnchanged = "unchanged"
+)
+
 // ReactionListArgs are the arguments passed to comment.Abandon RPC call
 type ReactionListArgs struct {
 	CommentIDs  string `json:"comment_ids"`
@@ -38,3 +61,63 @@ type Reactions map[string]CommentReaction
 
 // CommentReaction is a map for representing the reaction and its quantity for a comment
 type CommentReaction map[string]int
+
+// ReactionTypesArgs arguments for the reaction.Types rpc call. With a creator channel the types the creator enabled
+// on their content are returned as well.
+type ReactionTypesArgs struct {
+	CreatorChannelID *string `json:"creator_channel_id"`
+}
+
+// Validate validates the data in the args
+func (r ReactionTypesArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&r,
+		v.Field(&r.CreatorChannelID, validator.ClaimID),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ReactionType is a type of reaction that can be used on comments
+type ReactionType struct {
+	Name string `json:"name"`
+	// 1 if the reaction counts as positive when scoring comments, -1 if negative
+	Score int `json:"score,omitempty"`
+}
+
+// ReactionTypesResponse response for the reaction.Types rpc call
+type ReactionTypesResponse struct {
+	Global  []ReactionType `json:"global"`
+	Creator []ReactionType `json:"creator,omitempty"`
+}
+
+// ManageReactionTypeArgs arguments for the reaction.ManageType rpc call, signed by a global moderator. Adds the type
+// to the global allowlist or with remove takes it off. Types taken off stay usable where creators enabled them.
+type ManageReactionTypeArgs struct {
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	Name           string `json:"name"`
+	Remove         bool   `json:"remove"`
+	Signature      string `json:"signature"`
+	SigningTS      string `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (m ManageReactionTypeArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&m,
+		v.Field(&m.ModChannelID, validator.ClaimID, v.Required),
+		v.Field(&m.ModChannelName, v.Required),
+		v.Field(&m.Name, validator.ReactionName, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ManageReactionTypeResponse response for the reaction.ManageType rpc call
+type ManageReactionTypeResponse struct {
+	ReactionType
+	Global bool `json:"global"`
+}
@@ -28,22 +28,57 @@ type ListSettingsResponse struct {
 	SlowModeMinGap        *uint64  `json:"slow_mode_min_gap"`
 	CurseJarAmount        *uint64  `json:"curse_jar_amount"`
 	FiltersEnabled        *bool    `json:"filters_enabled,omitempty"`
+	// Live chat modes
+	EmoteOnly      *bool `json:"emote_only,omitempty"`
+	SupportersOnly *bool `json:"supporters_only,omitempty"`
+	// Number of minutes since a commenters first comment before they can comment
+	TimeSinceFirstComment *uint64 `json:"time_since_first_comment,omitempty"`
+	// Tiers deciding how long hyperchats stay on the ticker, none when the creator turned the ticker off
+	TickerTiers []TickerTier `json:"ticker_tiers,omitempty"`
+	// Reaction types the creator enabled on their content on top of the global ones
+	ReactionTypes []string `json:"reaction_types,omitempty"`
 }
 
-// UpdateSettingsArgs arguments for different settings that could be set
+// UpdateSettingsArgs arguments for different settings that could be set. The settings passed are signed after the
+// channel id as one JSON object, see lbry.CanonicalArgs: the non null arguments other than the channels and signature,
+// with sorted keys and no whitespace.
 type UpdateSettingsArgs struct {
 	Authorization
+	// Delegates need PermissionSettings
+	Delegation
 	CommentsEnabled       *bool    `json:"comments_enabled"`
 	MinTipAmountComment   *float64 `json:"min_tip_amount_comment"`
 	MinTipAmountSuperChat *float64 `json:"min_tip_amount_super_chat"`
 	SlowModeMinGap        *uint64  `json:"slow_mode_min_gap"`
 	CurseJarAmount        *uint64  `json:"curse_jar_amount"`
 	FiltersEnabled        *bool    `json:"filters_enabled"`
+	// Only allows comments made up entirely of emotes
+	EmoteOnly *bool `json:"emote_only"`
+	// Only allows commenters that have tipped the creator before
+	SupportersOnly *bool `json:"supporters_only"`
+	// Number of minutes since a commenters first comment before they can comment, 0 turns it off
+	TimeSinceFirstComment *uint64 `json:"time_since_first_comment"`
+	// The claim id of the live chat to notify of chat mode changes
+	ActiveClaimID *string `json:"active_claim_id"`
+	// Replaces the creator's ticker tiers, currencies without tiers use the defaults. An empty list turns the ticker off.
+	TickerTiers *[]TickerTier `json:"ticker_tiers"`
+	// Replaces the reaction types enabled on the creator's content on top of the global ones, ie custom emotes
+	ReactionTypes *[]string `json:"reaction_types"`
+}
+
+// TickerTier is the amount of a currency needed for a hyperchat to stay on the ticker for Duration seconds
+type TickerTier struct {
+	// LBC or the fiat currency code, ie USD
+	Currency  string  `json:"currency"`
+	MinAmount float64 `json:"min_amount"`
+	Duration  uint64  `json:"duration"`
 }
 
 // BlockWordArgs arguments passed to settings.BlockWord. Appends to list
 type BlockWordArgs struct {
 	Authorization
+	// Delegates need PermissionMutedWords
+	Delegation
 	// CSV list of containing words to block comment on content
 	Words string `json:"words"`
 }
@@ -54,6 +89,7 @@ func (b BlockWordArgs) Validate() api.StatusError {
 		v.Field(&b.ChannelID, validator.ClaimID, v.Required),
 		v.Field(&b.ChannelName, v.Required),
 		v.Field(&b.Words),
+		v.Field(&b.ModChannelID, validator.ClaimID),
 		v.Field(&b.Signature, v.Required),
 		v.Field(&b.SigningTS, v.Required),
 	)
@@ -74,6 +110,8 @@ type BlockWordRespose struct {
 // UnBlockWordArgs arguments passed to settings.UnBlockWord. Removes if exists
 type UnBlockWordArgs struct {
 	Authorization
+	// Delegates need PermissionMutedWords
+	Delegation
 	// CSV list of containing words to block comment on content
 	Words string `json:"words"`
 }
@@ -84,6 +122,7 @@ func (b UnBlockWordArgs) Validate() api.StatusError {
 		v.Field(&b.ChannelID, validator.ClaimID, v.Required),
 		v.Field(&b.ChannelName, v.Required),
 		v.Field(&b.Words),
+		v.Field(&b.ModChannelID, validator.ClaimID),
 		v.Field(&b.Signature, v.Required),
 		v.Field(&b.SigningTS, v.Required),
 	)
@@ -1,7 +1,16 @@
 package commentapi
 
-import "github.com/sirupsen/logrus"
+import (
+	"strconv"
 
+	"github.com/lbryio/commentron/helper"
+
+	"github.com/lbryio/lbry.go/v2/extras/util"
+	"github.com/sirupsen/logrus"
+)
+
+// sign signs the method digest of the args, the server rejects signatures whose signing_ts is too far from its clock
+// and signatures it already saw, so args have to be signed again for each call.
 func sign(client *Client, args interface{}) interface{} {
 	var updatedArgs interface{}
 	var err error
@@ -9,16 +18,18 @@ func sign(client *Client, args interface{}) interface{} {
 	case CreateArgs:
 		t.ChannelID = client.Channel.ChannelID
 		t.ChannelName = client.Channel.Name
-		t.Signature, t.SigningTS, err = client.Channel.Sign([]byte(t.CommentText))
+		t.Signature, t.SigningTS, err = client.Channel.Sign(helper.MethodDigest("comment.Create", t.ClaimID, util.StrFromPtr(t.ParentID), t.CommentText))
 		updatedArgs = t
 	case ReactArgs:
 		t.ChannelID = client.Channel.ChannelID
 		t.ChannelName = client.Channel.Name
-		t.Signature, t.SigningTS, err = client.Channel.Sign([]byte(client.Channel.Name))
+		t.Signature, t.SigningTS, err = client.Channel.Sign(helper.MethodDigest("reaction.React", t.CommentIDs, t.Type, strconv.FormatBool(t.Remove), t.ClearTypes))
+		updatedArgs = t
 	default:
-		if err != nil {
-			logrus.Panic("unknown type")
-		}
+		logrus.Panic("unknown type")
+	}
+	if err != nil {
+		logrus.Panic(err)
 	}
 	return updatedArgs
 }
@@ -0,0 +1,75 @@
+package commentapi
+
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// Intervals the creator stats can be bucketed by
+const (
+	IntervalHour = "hour"
+	IntervalDay  = "day"
+)
+
+// CreatorStatsArgs arguments for the stats.Creator rpc call
+type CreatorStatsArgs struct {
+	//Publisher or Moderator
+	ModChannelID   string `json:"mod_channel_id"`
+	ModChannelName string `json:"mod_channel_name"`
+	//Creator that Moderator is delegated from. Used for delegated moderation
+	CreatorChannelID   string `json:"creator_channel_id"`
+	CreatorChannelName string `json:"creator_channel_name"`
+	// If passed only the stats of this claim are returned, otherwise all claims of the creator
+	ClaimID *string `json:"claim_id"`
+	// hour or day, defaults to day
+	Interval string `json:"interval"`
+	// Unix timestamps of the range, defaults to the last 30 days
+	Since     int64  `json:"since"`
+	Until     int64  `json:"until"`
+	Signature string `json:"signature"`
+	SigningTS string `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (c CreatorStatsArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&c,
+		v.Field(&c.ModChannelID, validator.ClaimID, v.Required),
+		v.Field(&c.ModChannelName, v.Required),
+		v.Field(&c.ClaimID, validator.ClaimID),
+		v.Field(&c.Interval, v.In("", IntervalHour, IntervalDay)),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// StatsBucket is the activity on the creator's content in an hour or day
+type StatsBucket struct {
+	// Unix timestamp of the start of the bucket
+	Time int64 `json:"time"`
+	// Comments that were not flagged, flagged comments are only counted in Flagged
+	Comments         int64 `json:"comments"`
+	UniqueCommenters int64 `json:"unique_commenters"`
+	// Reactions keyed by reaction type
+	Reactions map[string]int64 `json:"reactions,omitempty"`
+	// Hyperchat totals keyed by currency, ie LBC or USD
+	HyperchatTotals map[string]float64 `json:"hyperchat_totals,omitempty"`
+	Hyperchats      int64              `json:"hyperchats"`
+	// Blocks made from a claim are counted for that claim, the others only for all claims of the creator
+	Blocks  int64 `json:"blocks"`
+	Flagged int64 `json:"flagged"`
+}
+
+// CreatorStatsResponse response for the stats.Creator rpc call
+type CreatorStatsResponse struct {
+	Interval string        `json:"interval"`
+	Since    int64         `json:"since"`
+	Until    int64         `json:"until"`
+	Buckets  []StatsBucket `json:"buckets"`
+}
@@ -11,15 +11,22 @@ type SuperListArgs struct {
 	Hidden        bool    `json:"hidden"`
 	// Satoshi amount to filter below >= x
 	SuperChatsAmount int `json:"super_chat"`
+	// Minimum amount of a hyperchat in its own currency, ie 5 is 5 LBC for LBC tips and 5 USD for USD tips
+	MinAmount *float64 `json:"min_amount"`
 }
 
 // SuperListResponse response for the comment.List rpc call
 type SuperListResponse struct {
-	Page              int           `json:"page"`
-	PageSize          int           `json:"page_size"`
-	TotalPages        int           `json:"total_pages"`
-	TotalItems        int64         `json:"total_items"`
-	TotalAmount       float64       `json:"total_amount"`
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalPages int   `json:"total_pages"`
+	TotalItems int64 `json:"total_items"`
+	// Total of the LBC hyperchats only, see TotalAmounts for all currencies
+	TotalAmount float64 `json:"total_amount"`
+	// Totals of the hyperchats keyed by currency, ie LBC or USD
+	TotalAmounts map[string]float64 `json:"total_amounts"`
+	// Approximate USD value of all hyperchats in currencies with a configured exchange rate
+	ApproxUSDTotal    float64       `json:"approx_usd_total"`
 	Items             []CommentItem `json:"items,omitempty"`
 	HasHiddenComments bool          `json:"has_hidden_comments"`
 }
@@ -38,3 +45,13 @@ func (c *SuperListArgs) ApplyDefaults() {
 		c.SuperChatsAmount = 1
 	}
 }
+
+// SuperChatTickerArgs arguments for the comment.SuperChatTicker rpc call
+type SuperChatTickerArgs struct {
+	ClaimID string `json:"claim_id"`
+}
+
+// SuperChatTickerResponse response for the comment.SuperChatTicker rpc call
+type SuperChatTickerResponse struct {
+	Items []CommentItem `json:"items"`
+}
@@ -0,0 +1,69 @@
+package commentapi
+
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// TopSupportersArgs arguments for the comment.TopSupporters rpc call. Either a claim or a creator channel is required.
+type TopSupportersArgs struct {
+	ClaimID          *string `json:"claim_id"`
+	CreatorChannelID *string `json:"creator_channel_id"`
+	// Measured in seconds for how far back hyperchats are counted. If 0 all hyperchats are counted.
+	Window   uint64 `json:"window"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+// Validate validates the data in the args
+func (t TopSupportersArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&t,
+		v.Field(&t.ClaimID, validator.ClaimID),
+		v.Field(&t.CreatorChannelID, validator.ClaimID),
+		v.Field(&t.PageSize, v.Max(100)),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	if t.ClaimID == nil && t.CreatorChannelID == nil {
+		return api.StatusError{Err: errors.Err("claim_id or creator_channel_id is required"), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ApplyDefaults applies the default values for arguments passed that are different from normal defaults.
+func (t *TopSupportersArgs) ApplyDefaults() {
+	if t.Page == 0 {
+		t.Page = 1
+	}
+	if t.PageSize == 0 {
+		t.PageSize = 50
+	}
+}
+
+// Supporter is a channel and what it has given in hyperchats
+type Supporter struct {
+	Rank        int    `json:"rank"`
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name,omitempty"`
+	ChannelURL  string `json:"channel_url,omitempty"`
+	// Totals of the hyperchats keyed by currency, ie LBC or USD
+	Totals         map[string]float64 `json:"totals"`
+	HyperchatCount int64              `json:"hyperchat_count"`
+	// Approximate USD value of the hyperchats in currencies with a configured exchange rate
+	ApproxUSDTotal float64 `json:"approx_usd_total"`
+}
+
+// TopSupportersResponse response for the comment.TopSupporters rpc call
+type TopSupportersResponse struct {
+	Page       int         `json:"page"`
+	PageSize   int         `json:"page_size"`
+	TotalPages int         `json:"total_pages"`
+	TotalItems int64       `json:"total_items"`
+	Items      []Supporter `json:"items"`
+}
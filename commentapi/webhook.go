@@ -0,0 +1,183 @@
+package commentapi
+
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// Events webhooks can be registered for
+const (
+	WebhookCreated   = "created"
+	WebhookEdited    = "edited"
+	WebhookDeleted   = "deleted"
+	WebhookBlocked   = "blocked"
+	WebhookHyperchat = "hyperchat"
+	// WebhookPing is only sent by the webhook.Test rpc call
+	WebhookPing = "ping"
+)
+
+// WebhookEvents are the events webhooks can be registered for
+var WebhookEvents = []string{WebhookCreated, WebhookEdited, WebhookDeleted, WebhookBlocked, WebhookHyperchat}
+
+// WebhookEvent is the JSON body posted to webhooks. It is signed with the secret of the webhook, the
+// X-Commentron-Signature header is "sha256=" followed by the hex HMAC-SHA256 of the X-Commentron-Timestamp header,
+// a "." and the body.
+type WebhookEvent struct {
+	Event     string `json:"event"`
+	Timestamp int64  `json:"timestamp"`
+	// A CommentItem for comment events, a BlockEvent for blocks and the payment of the hyperchat for hyperchats
+	Data interface{} `json:"data,omitempty"`
+}
+
+// BlockEvent is the data of the blocked webhook event and of the block and unblock changes of comment.Changes
+type BlockEvent struct {
+	BlockedChannelID   string `json:"blocked_channel_id"`
+	BlockedChannelName string `json:"blocked_channel_name"`
+	CreatorChannelID   string `json:"creator_channel_id"`
+	ModChannelID       string `json:"mod_channel_id"`
+	Universal          bool   `json:"universal,omitempty"`
+	// Unix timestamp of when the block expires, not set if it does not
+	Expiry *int64 `json:"expiry,omitempty"`
+}
+
+// Webhook is an endpoint the events of the claims of a creator, or every claim for admins, are sent to
+type Webhook struct {
+	ID     uint64   `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Global bool     `json:"global,omitempty"`
+	// Secret the events are signed with, only returned when the webhook is created
+	Secret    string `json:"secret,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// WebhookDelivery is an attempt to send an event to a webhook
+type WebhookDelivery struct {
+	ID         uint64 `json:"id"`
+	Event      string `json:"event"`
+	Attempts   int    `json:"attempts"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	// Unix timestamp the event was delivered, not set while it is being retried
+	DeliveredAt  *int64 `json:"delivered_at,omitempty"`
+	DeadLettered bool   `json:"dead_lettered,omitempty"`
+}
+
+// CreateWebhookArgs arguments for the webhook.Create rpc call. The webhook receives the events of the claims of the
+// channel, or of every claim when global is set, which requires the channel to be a global moderator.
+type CreateWebhookArgs struct {
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	Global      bool   `json:"global"`
+	// Creator webhooks must be https and resolve to a public address
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Signature string   `json:"signature"`
+	SigningTS string   `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (c CreateWebhookArgs) Validate() api.StatusError {
+	urlRule := validator.CreatorWebhookURL
+	if c.Global {
+		urlRule = validator.WebhookURL
+	}
+	err := v.ValidateStruct(&c,
+		v.Field(&c.ChannelID, validator.ClaimID, v.Required),
+		v.Field(&c.ChannelName, v.Required),
+		v.Field(&c.URL, urlRule, v.Required),
+		v.Field(&c.Events, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	for _, event := range c.Events {
+		if !isWebhookEvent(event) {
+			return api.StatusError{Err: errors.Err("'%s' is not a webhook event, it can be one of %v", event, WebhookEvents), Status: http.StatusBadRequest}
+		}
+	}
+	return api.StatusError{}
+}
+
+func isWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateWebhookResponse response for the webhook.Create rpc call
+type CreateWebhookResponse struct {
+	Webhook
+}
+
+// ListWebhooksArgs arguments for the webhook.List rpc call, global lists the webhooks of admins
+type ListWebhooksArgs struct {
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	Global      bool   `json:"global"`
+	Signature   string `json:"signature"`
+	SigningTS   string `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (l ListWebhooksArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&l,
+		v.Field(&l.ChannelID, validator.ClaimID, v.Required),
+		v.Field(&l.ChannelName, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// ListWebhooksResponse response for the webhook.List rpc call
+type ListWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// WebhookArgs arguments for the rpc calls on a single webhook: webhook.Remove, webhook.Deliveries and webhook.Test
+type WebhookArgs struct {
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	ID          uint64 `json:"id"`
+	Signature   string `json:"signature"`
+	SigningTS   string `json:"signing_ts"`
+}
+
+// Validate validates the data in the args
+func (w WebhookArgs) Validate() api.StatusError {
+	err := v.ValidateStruct(&w,
+		v.Field(&w.ChannelID, validator.ClaimID, v.Required),
+		v.Field(&w.ChannelName, v.Required),
+		v.Field(&w.ID, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	return api.StatusError{}
+}
+
+// RemoveWebhookResponse response for the webhook.Remove rpc call
+type RemoveWebhookResponse struct {
+	Removed bool `json:"removed"`
+}
+
+// WebhookDeliveriesResponse response for the webhook.Deliveries rpc call, the latest deliveries first
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// TestWebhookResponse response for the webhook.Test rpc call, the delivery of the ping event
+type TestWebhookResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
@@ -35,6 +35,9 @@ func InitializeConfiguration(conf *env.Config) {
 	}
 	initSlack(conf)
 	initStripe(conf)
+	initExchangeRates(conf)
+	initReactionScores(conf)
+	initSigning(conf)
 	SocketyToken = conf.SocketyToken
 
 }
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lbryio/commentron/env"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// exchangeRates is the value in USD of one unit of each currency, ie LBC or EUR
+var exchangeRates = map[string]float64{"USD": 1}
+
+func initExchangeRates(conf *env.Config) {
+	rates, err := parseExchangeRates(conf.ExchangeRates)
+	if err != nil {
+		logrus.Panic(err)
+	}
+	for currency, rate := range rates {
+		exchangeRates[currency] = rate
+	}
+}
+
+// parseExchangeRates parses a list of rates in the form `LBC:0.02,EUR:1.08`
+func parseExchangeRates(rates string) (map[string]float64, error) {
+	parsed := make(map[string]float64)
+	for _, entry := range strings.Split(rates, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, errors.Err("exchange rate '%s' should be in the form CURRENCY:RATE", entry)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return nil, errors.Err("exchange rate '%s' has an invalid rate: %s", entry, err)
+		}
+		if rate < 0 {
+			return nil, errors.Err("exchange rate '%s' cannot be negative", entry)
+		}
+		currency := strings.ToUpper(strings.TrimSpace(parts[0]))
+		if !currencyRegex.MatchString(currency) {
+			return nil, errors.Err("exchange rate '%s' has an invalid currency", entry)
+		}
+		parsed[currency] = rate
+	}
+	return parsed, nil
+}
+
+// USDRate returns the value in USD of one unit of the currency and whether a rate is configured for it
+func USDRate(currency string) (float64, bool) {
+	rate, ok := exchangeRates[strings.ToUpper(currency)]
+	return rate, ok
+}
+
+// ExchangeRates returns a copy of the configured USD exchange rates keyed by currency
+func ExchangeRates() map[string]float64 {
+	rates := make(map[string]float64, len(exchangeRates))
+	for currency, rate := range exchangeRates {
+		rates[currency] = rate
+	}
+	return rates
+}
@@ -0,0 +1,19 @@
+package config
+
+import "testing"
+
+func TestParseExchangeRates(t *testing.T) {
+	rates, err := parseExchangeRates(" lbc:0.02, EUR:1.08,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 2 || rates["LBC"] != 0.02 || rates["EUR"] != 1.08 {
+		t.Errorf("unexpected rates %v", rates)
+	}
+
+	for _, invalid := range []string{"LBC", "LBC:abc", "LBC:-1", "LBC:1:2", "LB'C:1"} {
+		if _, err := parseExchangeRates(invalid); err == nil {
+			t.Errorf("expected an error parsing '%s'", invalid)
+		}
+	}
+}
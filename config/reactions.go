@@ -0,0 +1,59 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/lbryio/commentron/env"
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+
+	"github.com/sirupsen/logrus"
+)
+
+// reactionScores is how each reaction type counts towards the scores of comments, 1 for positive and -1 for negative
+var reactionScores = map[string]int{"like": 1, "dislike": -1}
+
+func initReactionScores(conf *env.Config) {
+	scores, err := parseReactionScores(conf.PositiveReactions, conf.NegativeReactions)
+	if err != nil {
+		logrus.Panic(err)
+	}
+	reactionScores = scores
+}
+
+// parseReactionScores parses the comma separated names of the positive and negative reaction types
+func parseReactionScores(positive, negative string) (map[string]int, error) {
+	scores := make(map[string]int)
+	for score, names := range map[int]string{1: positive, -1: negative} {
+		for _, name := range strings.Split(names, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			if v.Validate(name, validator.ReactionName) != nil {
+				return nil, errors.Err("'%s' is not a valid reaction type name", name)
+			}
+			if _, ok := scores[name]; ok {
+				return nil, errors.Err("reaction type '%s' cannot be both positive and negative", name)
+			}
+			scores[name] = score
+		}
+	}
+	return scores, nil
+}
+
+// ReactionScore returns 1 if the reaction type counts as positive for scoring comments, -1 if negative, 0 otherwise
+func ReactionScore(name string) int {
+	return reactionScores[name]
+}
+
+// ScoringReactions returns the names of the reaction types that count for scoring comments
+func ScoringReactions() []string {
+	var names []string
+	for name := range reactionScores {
+		names = append(names, name)
+	}
+	return names
+}
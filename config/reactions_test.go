@@ -0,0 +1,19 @@
+package config
+
+import "testing"
+
+func TestParseReactionScores(t *testing.T) {
+	scores, err := parseReactionScores("like, creator_like", "dislike,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scores) != 3 || scores["like"] != 1 || scores["creator_like"] != 1 || scores["dislike"] != -1 {
+		t.Errorf("unexpected scores %v", scores)
+	}
+
+	for _, invalid := range [][2]string{{"like", "like"}, {"Like", ""}, {"", "dis like"}} {
+		if _, err := parseReactionScores(invalid[0], invalid[1]); err == nil {
+			t.Errorf("expected an error parsing %v", invalid)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/env"
+)
+
+// SigningWindow is how far the signing_ts of a signature can be from the server time for it to be accepted. Method
+// digest signatures of calls that change something are also remembered for twice the window and shared between the
+// instances to reject replays. 0 turns both checks off.
+var SigningWindow time.Duration
+
+// LegacySignatures allows calls signed with the data clients signed before method digests, ie just the channel name,
+// while the clients are being updated. Legacy signatures are not checked for replays as those clients reuse them across
+// calls. Methods added after method digests never accept them.
+var LegacySignatures bool
+
+func initSigning(conf *env.Config) {
+	SigningWindow = conf.SigningWindow
+	LegacySignatures = conf.LegacySignatures
+}
@@ -4,10 +4,14 @@ import "github.com/lbryio/commentron/env"
 
 var connectAPIKey string
 var connectAPIKeyTest string
+var webhookSecret string
+var webhookSecretTest string
 
 func initStripe(conf *env.Config) {
 	connectAPIKey = conf.StripeConnectAPIKey
 	connectAPIKeyTest = conf.StripeConnectAPIKeyTest
+	webhookSecret = conf.StripeWebhookSecret
+	webhookSecretTest = conf.StripeWebhookSecretTest
 }
 
 // Environment is a type representing a stripe environment
@@ -31,3 +35,14 @@ func ConnectAPIKey(env Environment) string {
 	}
 	return connectAPIKeyTest
 }
+
+// WebhookSecrets returns the configured signing secrets of the stripe webhook endpoint for both environments
+func WebhookSecrets() []string {
+	var secrets []string
+	for _, secret := range []string{webhookSecret, webhookSecretTest} {
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,34 @@
+// Package dbtest connects the tests that need a database to one. They are skipped unless TEST_MYSQL_DSN is set to a
+// database the tests can migrate and empty, ie TEST_MYSQL_DSN="commentron:commentron@tcp(localhost:3306)/commentron_test"
+package dbtest
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/lbryio/commentron/db"
+)
+
+var initOnce sync.Once
+var initErr error
+
+// Init connects db.RW and db.RO to the test database and empties the tables, it skips the test without a database
+func Init(t *testing.T, tables ...string) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN is not set")
+	}
+	initOnce.Do(func() {
+		initErr = db.Init(dsn, dsn, false)
+	})
+	if initErr != nil {
+		t.Fatal(initErr)
+	}
+	for _, table := range tables {
+		_, err := db.RW.Exec("DELETE FROM " + table)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
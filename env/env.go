@@ -1,6 +1,8 @@
 package env
 
 import (
+	"time"
+
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
 	e "github.com/caarlos0/env"
@@ -8,19 +10,26 @@ import (
 
 // Config holds the environment configuration used by lighthouse.
 type Config struct {
-	MySQLDsnRW              string `env:"MYSQL_DSN_RW"`
-	MySQLDsnRO              string `env:"MYSQL_DSN_RO"`
-	IsTestMode              bool   `env:"IS_TEST"`
-	SDKUrl                  string `env:"SDK_URL"`
-	SlackHookURL            string `env:"SLACKHOOKURL"`
-	SlackChannel            string `env:"SLACKCHANNEL"`
-	APIURL                  string `env:"APIURL" envDefault:"https://api.lbry.com/event/comment"`
-	APIToken                string `env:"APITOKEN"`
-	SocketyToken            string `env:"SOCKETY_TOKEN"`
-	TestChannel             string `env:"TEST_CHANNEL"`
-	TestURL                 string `env:"TEST_URL" envDefault:"http://localhost:5900/api/v2"`
-	StripeConnectAPIKey     string `env:"STRIPE_CONNECT_API_KEY"`
-	StripeConnectAPIKeyTest string `env:"STRIPE_CONNECT_API_KEY_TEST"`
+	MySQLDsnRW              string        `env:"MYSQL_DSN_RW"`
+	MySQLDsnRO              string        `env:"MYSQL_DSN_RO"`
+	IsTestMode              bool          `env:"IS_TEST"`
+	SDKUrl                  string        `env:"SDK_URL"`
+	SlackHookURL            string        `env:"SLACKHOOKURL"`
+	SlackChannel            string        `env:"SLACKCHANNEL"`
+	APIURL                  string        `env:"APIURL" envDefault:"https://api.lbry.com/event/comment"`
+	APIToken                string        `env:"APITOKEN"`
+	SocketyToken            string        `env:"SOCKETY_TOKEN"`
+	TestChannel             string        `env:"TEST_CHANNEL"`
+	TestURL                 string        `env:"TEST_URL" envDefault:"http://localhost:5900/api/v2"`
+	StripeConnectAPIKey     string        `env:"STRIPE_CONNECT_API_KEY"`
+	StripeConnectAPIKeyTest string        `env:"STRIPE_CONNECT_API_KEY_TEST"`
+	StripeWebhookSecret     string        `env:"STRIPE_WEBHOOK_SECRET"`
+	StripeWebhookSecretTest string        `env:"STRIPE_WEBHOOK_SECRET_TEST"`
+	ExchangeRates           string        `env:"EXCHANGE_RATES"`
+	PositiveReactions       string        `env:"POSITIVE_REACTIONS" envDefault:"like"`
+	NegativeReactions       string        `env:"NEGATIVE_REACTIONS" envDefault:"dislike"`
+	SigningWindow           time.Duration `env:"SIGNING_WINDOW" envDefault:"10m"`
+	LegacySignatures        bool          `env:"LEGACY_SIGNATURES" envDefault:"true"`
 }
 
 // NewWithEnvVars creates an Config from environment variables
@@ -6,7 +6,11 @@ import (
 
 // CheckComment checks and flags comments for deletion due to spam or key phrases
 func CheckComment(proposedComment *model.Comment) error {
-	if _, found := commentSpammers[proposedComment.ChannelID.String]; found {
+	spammer, err := isSpammer(SpamComment, proposedComment.ChannelID.String)
+	if err != nil {
+		return err
+	}
+	if spammer {
 		proposedComment.IsFlagged = true
 	}
 
@@ -20,7 +24,11 @@ func CheckComment(proposedComment *model.Comment) error {
 
 // CheckReaction checks reactions for spammers and flags reaction for deletion.
 func CheckReaction(proposedReaction *model.Reaction) error {
-	if _, found := reactionSpammers[proposedReaction.ChannelID.String]; found {
+	spammer, err := isSpammer(SpamReaction, proposedReaction.ChannelID.String)
+	if err != nil {
+		return err
+	}
+	if spammer {
 		proposedReaction.IsFlagged = true
 	}
 	return nil
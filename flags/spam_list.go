@@ -0,0 +1,46 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/karlseguin/ccache"
+)
+
+// Kinds of spam lists global moderators manage, see moderation.ManageSpammer
+const (
+	SpamComment  = "comment"
+	SpamReaction = "reaction"
+)
+
+const spammerCacheTime = time.Minute
+
+var spammerCache = ccache.New(ccache.Configure().MaxSize(10000))
+
+// isSpammer returns whether the channel is on the spam list of the kind, either the built in one or the one managed by
+// global moderators
+func isSpammer(kind, channelID string) (bool, error) {
+	builtIn := commentSpammers
+	if kind == SpamReaction {
+		builtIn = reactionSpammers
+	}
+	if builtIn[channelID] {
+		return true, nil
+	}
+	item, err := spammerCache.Fetch(kind+":"+channelID, spammerCacheTime, func() (interface{}, error) {
+		return model.Spammers(model.SpammerWhere.Kind.EQ(kind), model.SpammerWhere.ChannelID.EQ(channelID)).Exists(db.RO)
+	})
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return item.Value().(bool), nil
+}
+
+// ForgetSpammer drops the cached spam list entry of the channel after the spam list changed
+func ForgetSpammer(kind, channelID string) {
+	spammerCache.Delete(kind + ":" + channelID)
+}
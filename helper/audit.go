@@ -0,0 +1,62 @@
+package helper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Audit is a moderation action to record in the audit log read by moderation.AuditLog. Empty ids are not recorded,
+// Before and After are stored as JSON when set.
+type Audit struct {
+	Action           string
+	ActorChannelID   string
+	CreatorChannelID string
+	TargetChannelID  string
+	ClaimID          string
+	CommentID        string
+	Before           interface{}
+	After            interface{}
+	Reason           string
+	// APIKey is the name of the api key the actor made the action with, without signing it
+	APIKey string
+}
+
+// RecordAction appends the moderation action to the audit log
+func RecordAction(exec boil.Executor, audit Audit) error {
+	action := &model.ModerationAction{
+		Action:           audit.Action,
+		ActorChannelID:   audit.ActorChannelID,
+		CreatorChannelID: null.NewString(audit.CreatorChannelID, audit.CreatorChannelID != ""),
+		TargetChannelID:  null.NewString(audit.TargetChannelID, audit.TargetChannelID != ""),
+		ClaimID:          null.NewString(audit.ClaimID, audit.ClaimID != ""),
+		CommentID:        null.NewString(audit.CommentID, audit.CommentID != ""),
+		Reason:           null.NewString(audit.Reason, audit.Reason != ""),
+		APIKey:           null.NewString(audit.APIKey, audit.APIKey != ""),
+		CreatedAt:        time.Now(),
+	}
+	for _, state := range []struct {
+		data   interface{}
+		column *null.String
+	}{{audit.Before, &action.BeforeJSON}, {audit.After, &action.AfterJSON}} {
+		if state.data == nil {
+			continue
+		}
+		b, err := json.Marshal(state.data)
+		if err != nil {
+			return errors.Err(err)
+		}
+		state.column.SetValid(string(b))
+	}
+	err := action.Insert(exec, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
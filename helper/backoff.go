@@ -0,0 +1,16 @@
+package helper
+
+import "time"
+
+// Backoff is how long to wait before trying something again after it failed attempts times, starting at first and
+// doubling with each attempt up to max
+func Backoff(attempts int, first, max time.Duration) time.Duration {
+	delay := first
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
@@ -0,0 +1,26 @@
+package helper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		delay    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{100, time.Hour},
+	}
+	for _, test := range tests {
+		got := Backoff(test.attempts, 30*time.Second, time.Hour)
+		if got != test.delay {
+			t.Errorf("Backoff(%d) = %s, expected %s", test.attempts, got, test.delay)
+		}
+	}
+}
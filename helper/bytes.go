@@ -1,5 +1,7 @@
 package helper
 
+import "strconv"
+
 // CreateDigest utility function for grouping multiple sets of bytes. Largely used for signature verification
 func CreateDigest(pieces ...[]byte) []byte {
 	var digest []byte
@@ -8,3 +10,17 @@ func CreateDigest(pieces ...[]byte) []byte {
 	}
 	return digest
 }
+
+// MethodDigest is the data a channel signs to call an rpc method, ie comment.Create, covering the arguments that decide
+// what the call does. The method and then each field are written as their length in bytes, a colon, the piece itself
+// and a comma, so that no two calls share a digest.
+func MethodDigest(method string, fields ...string) []byte {
+	var digest []byte
+	for _, piece := range append([]string{method}, fields...) {
+		digest = strconv.AppendInt(digest, int64(len(piece)), 10)
+		digest = append(digest, ':')
+		digest = append(digest, piece...)
+		digest = append(digest, ',')
+	}
+	return digest
+}
@@ -0,0 +1,16 @@
+package helper
+
+import "testing"
+
+func TestMethodDigest(t *testing.T) {
+	digest := string(MethodDigest("comment.Edit", "abc", "hi, there"))
+	if digest != "12:comment.Edit,3:abc,9:hi, there," {
+		t.Errorf("unexpected digest %s", digest)
+	}
+	if string(MethodDigest("m", "a,1:b")) == string(MethodDigest("m", "a", "b")) {
+		t.Error("different fields should never share a digest")
+	}
+	if string(MethodDigest("m", "")) == string(MethodDigest("m")) {
+		t.Error("an empty field should still be part of the digest")
+	}
+}
@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// RecordChange appends a change to the change log read by comment.Changes. The comment is nil for blocks, the channel
+// is the author of the comment, the channel that reacted for reactions or the blocked channel for blocks.
+func RecordChange(exec boil.Executor, kind string, comment *model.Comment, channelID string, data interface{}) error {
+	change := &model.CommentChange{
+		Kind:      kind,
+		ChannelID: null.NewString(channelID, channelID != ""),
+		CreatedAt: time.Now(),
+	}
+	if comment != nil {
+		change.CommentID.SetValid(comment.CommentID)
+		change.ClaimID.SetValid(comment.LbryClaimID)
+	}
+	if data != nil {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return errors.Err(err)
+		}
+		change.Data.SetValid(string(b))
+	}
+	err := change.Insert(exec, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
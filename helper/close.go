@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"database/sql"
 	"io"
 
 	"github.com/lbryio/lbry.go/v2/extras/errors"
@@ -22,3 +23,11 @@ func CloseWriter(w io.WriteCloser) {
 		logrus.Error(closeError)
 	}
 }
+
+// CloseRows used to catch and log errors from closing query result rows
+func CloseRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		closeError := errors.Prefix("closing rows error: ", errors.Err(err))
+		logrus.Error(closeError)
+	}
+}
@@ -0,0 +1,60 @@
+package helper
+
+import (
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+// AddReplies adjusts the reply count of a comment, to be called in the same transaction replies are created or deleted.
+// The count does not go below 0 when a reply it did not count is deleted.
+func AddReplies(exec boil.Executor, parentID string, delta int) error {
+	_, err := queries.Raw(`UPDATE `+m.TableNames.Comment+` SET `+m.CommentColumns.ReplyCount+` = GREATEST(`+m.CommentColumns.ReplyCount+` + ?, 0) WHERE `+m.CommentColumns.CommentID+` = ?`,
+		delta, parentID).Exec(exec)
+	return errors.Err(err)
+}
+
+// AddReactions adjusts the count of a reaction type on a comment, to be called in the same transaction reactions are
+// created or deleted. The count does not go below 0 when a reaction it did not count is deleted.
+func AddReactions(exec boil.Executor, commentID string, reactionTypeID uint64, delta int) error {
+	_, err := queries.Raw(`INSERT INTO `+m.TableNames.CommentReactionCount+` (comment_id, reaction_type_id, count) VALUES (?, ?, GREATEST(?, 0))
+		ON DUPLICATE KEY UPDATE count = GREATEST(count + ?, 0)`,
+		commentID, reactionTypeID, delta, delta).Exec(exec)
+	return errors.Err(err)
+}
+
+// RepairCounters recomputes the reply and reaction counters of every comment from the replies and reactions
+func RepairCounters() error {
+	return db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		statements := []struct {
+			name  string
+			query string
+		}{
+			{"reset reply counts", `UPDATE comment SET reply_count = 0 WHERE reply_count != 0`},
+			{"count replies", `UPDATE comment c
+				INNER JOIN (SELECT parent_id, COUNT(*) AS replies FROM comment WHERE parent_id IS NOT NULL GROUP BY parent_id) r
+				ON r.parent_id = c.comment_id
+				SET c.reply_count = r.replies`},
+			{"reset reaction counts", `DELETE FROM comment_reaction_count`},
+			{"count reactions", `INSERT INTO comment_reaction_count (comment_id, reaction_type_id, count)
+				SELECT comment_id, reaction_type_id, COUNT(*) FROM reaction GROUP BY comment_id, reaction_type_id`},
+		}
+		for _, s := range statements {
+			result, err := queries.Raw(s.query).Exec(tx)
+			if err != nil {
+				return errors.Prefix(s.name, err)
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return errors.Err(err)
+			}
+			logrus.Infof("Repair Counters: %s, %d rows", s.name, rows)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,184 @@
+package helper
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/db/dbtest"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+const (
+	counterChannelID = "599617e276c2704a3bff888991bd8a018df672a9"
+	counterClaimID   = "abe3c90453fd481383acb4e3d243e2f4efd43e02"
+)
+
+// initCounters creates a comment with two replies, one of which has a reply, and reactions on the comment and a reply
+func initCounters(t *testing.T) (*m.Comment, []*m.Comment, *m.ReactionType) {
+	dbtest.Init(t, m.TableNames.Reaction, m.TableNames.CommentReactionCount, m.TableNames.Comment)
+	_, err := queries.Raw(`INSERT IGNORE INTO `+m.TableNames.Channel+` (claim_id, name) VALUES (?, ?)`, counterChannelID, "@counters").Exec(db.RW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	like, err := m.ReactionTypes(m.ReactionTypeWhere.Name.EQ("like")).One(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newComment := func(id, parentID string) *m.Comment {
+		c := &m.Comment{
+			CommentID:   id,
+			LbryClaimID: counterClaimID,
+			ChannelID:   null.StringFrom(counterChannelID),
+			Body:        id,
+			ParentID:    null.NewString(parentID, parentID != ""),
+		}
+		err := c.Insert(db.RW, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	comment := newComment(strings.Repeat("a", 64), "")
+	replies := []*m.Comment{newComment(strings.Repeat("b", 64), comment.CommentID), newComment(strings.Repeat("c", 64), comment.CommentID)}
+	newComment(strings.Repeat("d", 64), replies[0].CommentID)
+	for _, commentID := range []string{comment.CommentID, comment.CommentID, replies[0].CommentID} {
+		reaction := &m.Reaction{CommentID: commentID, ChannelID: null.StringFrom(counterChannelID), ClaimID: counterClaimID, ReactionTypeID: like.ID}
+		err := reaction.Insert(db.RW, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return comment, replies, like
+}
+
+func replyCount(t *testing.T, commentID string) int {
+	comment, err := m.FindComment(db.RO, commentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return comment.ReplyCount
+}
+
+func reactionCount(t *testing.T, commentID string, reactionTypeID uint64) int {
+	count, err := m.FindCommentReactionCount(db.RO, commentID, reactionTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count.Count
+}
+
+func TestAddReplies(t *testing.T) {
+	comment, _, _ := initCounters(t)
+	for _, test := range []struct {
+		delta    int
+		expected int
+	}{{1, 1}, {1, 2}, {-1, 1}, {-1, 0}, {-1, 0}} {
+		err := AddReplies(db.RW, comment.CommentID, test.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count := replyCount(t, comment.CommentID); count != test.expected {
+			t.Errorf("expected %d replies after adding %d, got %d", test.expected, test.delta, count)
+		}
+	}
+}
+
+func TestAddReactions(t *testing.T) {
+	comment, replies, like := initCounters(t)
+	for _, test := range []struct {
+		delta    int
+		expected int
+	}{{1, 1}, {1, 2}, {-1, 1}, {-1, 0}, {-1, 0}} {
+		err := AddReactions(db.RW, comment.CommentID, like.ID, test.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count := reactionCount(t, comment.CommentID, like.ID); count != test.expected {
+			t.Errorf("expected %d likes after adding %d, got %d", test.expected, test.delta, count)
+		}
+	}
+	err := AddReactions(db.RW, replies[1].CommentID, like.ID, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := reactionCount(t, replies[1].CommentID, like.ID); count != 0 {
+		t.Errorf("removing a reaction that was not counted should count 0, got %d", count)
+	}
+}
+
+// counters is the reply count of each comment and the count of each reaction type of each comment
+func counters(t *testing.T) (map[string]int, map[string]int) {
+	comments, err := m.Comments().All(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replies := make(map[string]int)
+	for _, c := range comments {
+		replies[c.CommentID] = c.ReplyCount
+	}
+	counts, err := m.CommentReactionCounts().All(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reactions := make(map[string]int)
+	for _, c := range counts {
+		reactions[c.CommentID] += c.Count
+	}
+	return replies, reactions
+}
+
+// backfill runs the statements of the migration that first counted the replies and reactions
+func backfill(t *testing.T) {
+	migration, err := ioutil.ReadFile("../migration/017_comment_counters.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range strings.Split(string(migration), "-- +migrate StatementBegin") {
+		statement = strings.TrimSpace(strings.Replace(statement, "-- +migrate StatementEnd", "", 1))
+		if !strings.HasPrefix(statement, "UPDATE") && !strings.HasPrefix(statement, "INSERT") {
+			continue
+		}
+		_, err := queries.Raw(statement).Exec(db.RW)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRepairCounters(t *testing.T) {
+	comment, replies, like := initCounters(t)
+	backfill(t)
+	expectedReplies, expectedReactions := counters(t)
+	if expectedReplies[comment.CommentID] != 2 || expectedReplies[replies[0].CommentID] != 1 || expectedReactions[comment.CommentID] != 2 {
+		t.Fatalf("unexpected backfill %v %v", expectedReplies, expectedReactions)
+	}
+
+	for _, c := range []*m.Comment{comment, replies[0], replies[1]} {
+		err := AddReplies(db.RW, c.CommentID, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = AddReactions(db.RW, c.CommentID, like.ID, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := RepairCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repairedReplies, repairedReactions := counters(t)
+	if !reflect.DeepEqual(repairedReplies, expectedReplies) {
+		t.Errorf("expected the replies of the backfill %v, got %v", expectedReplies, repairedReplies)
+	}
+	if !reflect.DeepEqual(repairedReactions, expectedReactions) {
+		t.Errorf("expected the reactions of the backfill %v, got %v", expectedReactions, repairedReactions)
+	}
+}
@@ -3,6 +3,8 @@ package helper
 import (
 	"database/sql"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/lbryio/commentron/db"
 	m "github.com/lbryio/commentron/model"
@@ -11,6 +13,7 @@ import (
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
 	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
 // AllowedToRespond checks if the creator of the comment will allow a response from the respondent
@@ -39,3 +42,75 @@ func AllowedToRespond(parentCommentID, commenterClaimID string) error {
 	}
 	return nil
 }
+
+// GetModerator returns the moderator channel and the creator channel it moderates for. Without a creator the moderator
+// is moderating their own channel, otherwise they must be a delegated moderator of the creator with all the
+// commentapi.Permission* bits of permissions. Delegates scoped to some claims of the creator can only moderate the
+// claim passed, calls that are not about a single claim pass an empty claim id.
+func GetModerator(modChannelID, modChannelName, creatorChannelID, creatorChannelName, claimID string, permissions uint64) (*m.Channel, *m.Channel, error) {
+	modChannel, err := FindOrCreateChannel(modChannelID, modChannelName)
+	if err != nil {
+		return nil, nil, errors.Err(err)
+	}
+	var creatorChannel = modChannel
+	if creatorChannelID != "" && creatorChannelName != "" {
+		creatorChannel, err = FindOrCreateChannel(creatorChannelID, creatorChannelName)
+		if err != nil {
+			return nil, nil, errors.Err(err)
+		}
+		delegate, err := ActiveDelegate(modChannel.ClaimID, creatorChannel.ClaimID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if delegate == nil {
+			return nil, nil, errors.Err("%s is not delegated by %s to be a moderator", modChannel.Name, creatorChannel.Name)
+		}
+		if delegate.Permissons&permissions != permissions {
+			return nil, nil, api.StatusError{Err: errors.Err("%s does not have the permissions of %s to do this", modChannel.Name, creatorChannel.Name), Status: http.StatusForbidden}
+		}
+		if !InClaimScope(delegate, claimID) {
+			return nil, nil, api.StatusError{Err: errors.Err("%s can only moderate some claims of %s", modChannel.Name, creatorChannel.Name), Status: http.StatusForbidden}
+		}
+	}
+	return modChannel, creatorChannel, nil
+}
+
+// activeDelegate is commentapi.DelegateStatusActive, delegates are only moderators once they accepted the invite
+const activeDelegate = "active"
+
+// ActiveDelegates filters delegated moderators to those that accepted the invite of the creator and did not expire
+func ActiveDelegates() qm.QueryMod {
+	status := m.TableNames.DelegatedModerator + "." + m.DelegatedModeratorColumns.Status
+	expiresAt := m.TableNames.DelegatedModerator + "." + m.DelegatedModeratorColumns.ExpiresAt
+	return qm.Where(status+" = ? AND ("+expiresAt+" IS NULL OR "+expiresAt+" > ?)", activeDelegate, time.Now())
+}
+
+// InClaimScope returns whether the delegate can moderate the claim, delegates without a claim scope moderate all claims
+// of the creator and are the only ones that can take actions that are not about a single claim
+func InClaimScope(delegate *m.DelegatedModerator, claimID string) bool {
+	if !delegate.ClaimScope.Valid {
+		return true
+	}
+	for _, scoped := range strings.Split(delegate.ClaimScope.String, ",") {
+		if claimID != "" && scoped == claimID {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveDelegate returns the delegation of the mod channel by the creator, nil if it is not an active delegate. It can
+// be scoped to some claims of the creator, see InClaimScope.
+func ActiveDelegate(modChannelID, creatorChannelID string) (*m.DelegatedModerator, error) {
+	delegate, err := m.DelegatedModerators(
+		m.DelegatedModeratorWhere.ModChannelID.EQ(modChannelID),
+		m.DelegatedModeratorWhere.CreatorChannelID.EQ(creatorChannelID),
+		ActiveDelegates()).One(db.RO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Err(err)
+	}
+	return delegate, nil
+}
@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"testing"
+
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+)
+
+func TestInClaimScope(t *testing.T) {
+	scoped := &m.DelegatedModerator{ClaimScope: null.StringFrom("claim1,claim2")}
+	tests := []struct {
+		delegate *m.DelegatedModerator
+		claimID  string
+		inScope  bool
+	}{
+		{&m.DelegatedModerator{}, "", true},
+		{&m.DelegatedModerator{}, "claim1", true},
+		{scoped, "claim1", true},
+		{scoped, "claim2", true},
+		{scoped, "claim3", false},
+		{scoped, "", false},
+		{scoped, "claim1,claim2", false},
+	}
+	for _, test := range tests {
+		if InClaimScope(test.delegate, test.claimID) != test.inScope {
+			t.Errorf("InClaimScope(%q, %q) should be %v", test.delegate.ClaimScope.String, test.claimID, test.inScope)
+		}
+	}
+}
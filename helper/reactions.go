@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"database/sql"
+	"net/http"
+
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// FindReactionType returns the registered reaction type of the name, unknown names are rejected
+func FindReactionType(exec boil.Executor, name string) (*m.ReactionType, error) {
+	reactionType, err := m.ReactionTypes(m.ReactionTypeWhere.Name.EQ(name)).One(exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, api.StatusError{Err: errors.Err("'%s' is not a known reaction type", name), Status: http.StatusBadRequest}
+	}
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	return reactionType, nil
+}
+
+// AllowedReactionType checks the reaction type is on the global allowlist or enabled by the creator of the content
+func AllowedReactionType(exec boil.Executor, reactionType *m.ReactionType, creatorChannelID string) error {
+	if reactionType.IsGlobal {
+		return nil
+	}
+	if creatorChannelID != "" {
+		creatorChannel := &m.Channel{ClaimID: creatorChannelID}
+		enabled, err := creatorChannel.ReactionTypes(m.ReactionTypeWhere.ID.EQ(reactionType.ID)).Exists(exec)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if enabled {
+			return nil
+		}
+	}
+	return api.StatusError{Err: errors.Err("the reaction type '%s' is not enabled on this content", reactionType.Name), Status: http.StatusBadRequest}
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"math"
+	"time"
+)
+
+// LikeReaction is the name of the reaction type creators like comments with
+const LikeReaction = "like"
+
+// hotGravity is how fast the hot score of a comment decays with its age
+const hotGravity = 1.8
+
+// wilsonZ is the z-score of the 95% confidence the best score is computed with
+const wilsonZ = 1.96
+
+// HotScore ranks comments by their net likes, decaying with the hours since they were made so new comments can rise
+// above old popular ones. The score changes over time so it needs to be recomputed periodically.
+func HotScore(likes, dislikes int, created, now time.Time) float64 {
+	hours := now.Sub(created).Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	return float64(likes-dislikes+1) / math.Pow(hours+2, hotGravity)
+}
+
+// BestScore is the lower bound of the Wilson score interval of the share of likes, so comments with few reactions
+// do not outrank comments with many mostly positive reactions.
+func BestScore(likes, dislikes int) float64 {
+	n := float64(likes + dislikes)
+	if n <= 0 {
+		return 0
+	}
+	p := float64(likes) / n
+	z2 := wilsonZ * wilsonZ
+	return (p + z2/(2*n) - wilsonZ*math.Sqrt((p*(1-p)+z2/(4*n))/n)) / (1 + z2/n)
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBestScore(t *testing.T) {
+	tests := []struct {
+		likes, dislikes int
+		expected        float64
+	}{
+		{0, 0, 0},
+		{1, 0, 0.2065},
+		{10, 0, 0.7225},
+		{100, 10, 0.8407},
+		{0, 10, 0},
+	}
+	for _, test := range tests {
+		score := BestScore(test.likes, test.dislikes)
+		if math.Abs(score-test.expected) > 0.0001 {
+			t.Errorf("%d likes %d dislikes: expected %.4f got %.4f", test.likes, test.dislikes, test.expected, score)
+		}
+	}
+	if BestScore(100, 10) <= BestScore(3, 0) {
+		t.Error("a comment with many mostly positive reactions should beat one with a few positive reactions")
+	}
+}
+
+func TestHotScore(t *testing.T) {
+	now := time.Now()
+	fresh := HotScore(0, 0, now, now)
+	oldPopular := HotScore(50, 0, now.Add(-7*24*time.Hour), now)
+	newLiked := HotScore(5, 0, now.Add(-time.Hour), now)
+	if newLiked <= oldPopular {
+		t.Errorf("a recent liked comment should beat an old popular one: %f <= %f", newLiked, oldPopular)
+	}
+	if fresh <= HotScore(0, 0, now.Add(-time.Hour), now) {
+		t.Error("the score should decay with age")
+	}
+	if HotScore(0, 5, now, now) >= fresh {
+		t.Error("dislikes should lower the score")
+	}
+}
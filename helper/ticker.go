@@ -0,0 +1,141 @@
+package helper
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/volatiletech/null"
+)
+
+// LBC is the currency used for hyperchats paid with LBC tips
+const LBC = "LBC"
+
+// defaultFiatCurrency is the currency whose default tiers are used for any fiat currency the creator has not configured
+const defaultFiatCurrency = "USD"
+
+// ZeroDecimalCurrencies are the fiat currencies stripe charges in whole units instead of cents
+var ZeroDecimalCurrencies = []string{"BIF", "CLP", "DJF", "GNF", "JPY", "KMF", "KRW", "MGA", "PYG", "RWF", "UGX", "VND", "VUV", "XAF", "XOF", "XPF"}
+
+// defaultTickerTiers are used when a creator has not configured tiers for a currency. Amounts are in dewies for LBC and cents for fiat.
+var defaultTickerTiers = m.TickerTierSlice{
+	{Currency: LBC, MinAmount: 1 * btcutil.SatoshiPerBitcoin, Duration: 60},
+	{Currency: LBC, MinAmount: 10 * btcutil.SatoshiPerBitcoin, Duration: 5 * 60},
+	{Currency: LBC, MinAmount: 50 * btcutil.SatoshiPerBitcoin, Duration: 15 * 60},
+	{Currency: LBC, MinAmount: 100 * btcutil.SatoshiPerBitcoin, Duration: 30 * 60},
+	{Currency: LBC, MinAmount: 500 * btcutil.SatoshiPerBitcoin, Duration: 60 * 60},
+	{Currency: defaultFiatCurrency, MinAmount: 100, Duration: 60},
+	{Currency: defaultFiatCurrency, MinAmount: 500, Duration: 5 * 60},
+	{Currency: defaultFiatCurrency, MinAmount: 1000, Duration: 15 * 60},
+	{Currency: defaultFiatCurrency, MinAmount: 2000, Duration: 30 * 60},
+	{Currency: defaultFiatCurrency, MinAmount: 5000, Duration: 60 * 60},
+}
+
+// TickerCurrency returns the currency a hyperchat was paid in, LBC or the upper case fiat currency
+func TickerCurrency(comment *m.Comment) string {
+	if !comment.IsFiat {
+		return LBC
+	}
+	return strings.ToUpper(comment.Currency.String)
+}
+
+// GetTickerTiers returns the ticker tiers of the creator, falling back to the defaults for LBC and USD if not configured.
+// There are none when the creator turned the ticker off by saving an empty list of tiers.
+func GetTickerTiers(creatorChannelID string) (m.TickerTierSlice, error) {
+	disabled, err := m.CreatorSettings(
+		m.CreatorSettingWhere.CreatorChannelID.EQ(creatorChannelID),
+		m.CreatorSettingWhere.TickerDisabled.EQ(null.BoolFrom(true))).Exists(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if disabled {
+		return nil, nil
+	}
+	tiers, err := m.TickerTiers(m.TickerTierWhere.CreatorChannelID.EQ(creatorChannelID)).All(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	configured := make(map[string]bool)
+	for _, t := range tiers {
+		configured[t.Currency] = true
+	}
+	for _, t := range defaultTickerTiers {
+		if !configured[t.Currency] {
+			tiers = append(tiers, t)
+		}
+	}
+	sort.SliceStable(tiers, func(i, j int) bool {
+		if tiers[i].Currency != tiers[j].Currency {
+			return tiers[i].Currency < tiers[j].Currency
+		}
+		return tiers[i].MinAmount < tiers[j].MinAmount
+	})
+	return tiers, nil
+}
+
+// TickerDuration returns how long a hyperchat of amount stays on the ticker. The highest tier whose min amount is met
+// wins. Fiat currencies without tiers of their own use the USD tiers.
+func TickerDuration(tiers m.TickerTierSlice, currency string, amount uint64) time.Duration {
+	tiersFor := func(currency string) m.TickerTierSlice {
+		var filtered m.TickerTierSlice
+		for _, t := range tiers {
+			if t.Currency == currency {
+				filtered = append(filtered, t)
+			}
+		}
+		return filtered
+	}
+	currencyTiers := tiersFor(currency)
+	if len(currencyTiers) == 0 && currency != LBC {
+		currencyTiers = tiersFor(defaultFiatCurrency)
+	}
+	var best *m.TickerTier
+	for _, t := range currencyTiers {
+		if amount >= t.MinAmount && (best == nil || t.MinAmount > best.MinAmount) {
+			best = t
+		}
+	}
+	if best == nil {
+		return 0
+	}
+	return time.Duration(best.Duration) * time.Second
+}
+
+// ToBaseUnits converts an amount of the currency to dewies for LBC, cents for fiat or whole units for zero decimal fiat
+func ToBaseUnits(currency string, amount float64) (uint64, error) {
+	if currency == LBC {
+		lbc, err := btcutil.NewAmount(amount)
+		if err != nil {
+			return 0, errors.Err(err)
+		}
+		return uint64(lbc), nil
+	}
+	if amount < 0 {
+		return 0, errors.Err("amount cannot be negative")
+	}
+	return uint64(amount*fiatUnits(currency) + 0.5), nil
+}
+
+// FromBaseUnits converts dewies for LBC, cents for fiat or whole units for zero decimal fiat into an amount of the currency
+func FromBaseUnits(currency string, amount uint64) float64 {
+	if currency == LBC {
+		return btcutil.Amount(amount).ToBTC()
+	}
+	return float64(amount) / fiatUnits(currency)
+}
+
+// fiatUnits is the number of base units in one unit of the fiat currency
+func fiatUnits(currency string) float64 {
+	for _, c := range ZeroDecimalCurrencies {
+		if strings.EqualFold(c, currency) {
+			return 1
+		}
+	}
+	return 100
+}
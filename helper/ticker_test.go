@@ -0,0 +1,113 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/db/dbtest"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+func TestTickerDuration(t *testing.T) {
+	tiers := append(m.TickerTierSlice{
+		{Currency: "EUR", MinAmount: 200, Duration: 120},
+		{Currency: "EUR", MinAmount: 1000, Duration: 600},
+	}, defaultTickerTiers...)
+
+	tests := []struct {
+		currency string
+		amount   uint64
+		expected time.Duration
+	}{
+		{LBC, 50000000, 0},
+		{LBC, 100000000, time.Minute},
+		{LBC, 7500000000, 15 * time.Minute},
+		{LBC, 100000000000, time.Hour},
+		{"USD", 99, 0},
+		{"USD", 1999, 15 * time.Minute},
+		{"EUR", 500, 2 * time.Minute},
+		{"EUR", 5000, 10 * time.Minute},
+		{"CAD", 500, 5 * time.Minute},
+	}
+	for _, test := range tests {
+		duration := TickerDuration(tiers, test.currency, test.amount)
+		if duration != test.expected {
+			t.Errorf("%d %s: expected %s got %s", test.amount, test.currency, test.expected, duration)
+		}
+	}
+}
+
+func TestBaseUnits(t *testing.T) {
+	tests := []struct {
+		currency string
+		amount   float64
+		base     uint64
+	}{
+		{LBC, 1.5, 150000000},
+		{"USD", 12.34, 1234},
+		{"EUR", 0.05, 5},
+		{"JPY", 500, 500},
+		{"KRW", 1000, 1000},
+		{"jpy", 500, 500},
+	}
+	for _, test := range tests {
+		base, err := ToBaseUnits(test.currency, test.amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if base != test.base {
+			t.Errorf("%v %s: expected %d base units got %d", test.amount, test.currency, test.base, base)
+		}
+		amount := FromBaseUnits(test.currency, test.base)
+		if amount != test.amount {
+			t.Errorf("%d %s base units: expected %v got %v", test.base, test.currency, test.amount, amount)
+		}
+	}
+}
+
+func TestGetTickerTiers(t *testing.T) {
+	dbtest.Init(t, m.TableNames.TickerTier, m.TableNames.CreatorSetting)
+	creator, err := FindOrCreateChannel("9cb713f01bf247a0e03170b5ed00d5161340c486", "@ticker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	currencies := func() map[string]int {
+		tiers, err := GetTickerTiers(creator.ClaimID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts := make(map[string]int)
+		for _, tier := range tiers {
+			counts[tier.Currency]++
+		}
+		return counts
+	}
+
+	if counts := currencies(); counts[LBC] != 5 || counts["USD"] != 5 {
+		t.Errorf("expected the default tiers, got %v", counts)
+	}
+	tier := &m.TickerTier{CreatorChannelID: creator.ClaimID, Currency: LBC, MinAmount: 100000000, Duration: 60}
+	err = tier.Insert(db.RW, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts := currencies(); counts[LBC] != 1 || counts["USD"] != 5 {
+		t.Errorf("expected the LBC tier and the default USD tiers, got %v", counts)
+	}
+	err = tier.Delete(db.RW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := &m.CreatorSetting{CreatorChannelID: creator.ClaimID, TickerDisabled: null.BoolFrom(true)}
+	err = settings.Insert(db.RW, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts := currencies(); len(counts) != 0 {
+		t.Errorf("expected no tiers with the ticker turned off, got %v", counts)
+	}
+}
@@ -55,6 +55,46 @@ var (
 		Name:      "sdk_claim",
 		Help:      "SDK claim cache miss/hit",
 	}, []string{"type"})
+
+	// Notifications is the number of delivery attempts of the notification outbox by result
+	Notifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "commentron",
+		Subsystem: "notifications",
+		Name:      "deliveries",
+		Help:      "Delivery attempts of internal-apis notifications by result (delivered/failed/dead_lettered)",
+	}, []string{"result"})
+
+	// NotificationsPending is the number of notifications waiting in the outbox to be delivered
+	NotificationsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "commentron",
+		Subsystem: "notifications",
+		Name:      "pending",
+		Help:      "Number of internal-apis notifications waiting to be delivered",
+	})
+
+	// WebhookDeliveries is the number of delivery attempts of events to webhooks by result
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "commentron",
+		Subsystem: "webhooks",
+		Name:      "deliveries",
+		Help:      "Delivery attempts of webhook events by result (delivered/failed/dead_lettered)",
+	}, []string{"result"})
+
+	// Signatures is the number of signed calls by how they were signed or why they were rejected
+	Signatures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "commentron",
+		Subsystem: "signatures",
+		Name:      "validated",
+		Help:      "Signed calls by result (digest/legacy/expired/replayed)",
+	}, []string{"result"})
+
+	// APIKeyRequests is the number of requests made with each api key by result
+	APIKeyRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "commentron",
+		Subsystem: "api_keys",
+		Name:      "requests",
+		Help:      "Requests made with api keys by key name and result (accepted/rate_limited)",
+	}, []string{"key", "result"})
 )
 
 // SDKCall helper function for observing the duration
@@ -0,0 +1,17 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE creator_setting ADD COLUMN emote_only BOOLEAN DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE creator_setting ADD COLUMN supporters_only BOOLEAN DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE creator_setting ADD COLUMN time_since_first_comment BIGINT UNSIGNED DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_channel_amount (channel_id, amount), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN pinned_until BIGINT UNSIGNED DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_claim_pinned_until (lbry_claim_id, pinned_until), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE ticker_tier (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ creator_channel_id CHAR(40) NOT NULL,
+ currency           VARCHAR(3) NOT NULL,
+ min_amount         BIGINT UNSIGNED NOT NULL,
+ duration           BIGINT UNSIGNED NOT NULL,
+ created_at         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ updated_at         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ UNIQUE idx_unique_tier (creator_channel_id, currency, min_amount),
+ FOREIGN KEY fk_ticker_tier_creator (creator_channel_id) REFERENCES channel (claim_id) ON DELETE CASCADE
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN creator_channel_id CHAR(40) DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_creator_channel (creator_channel_id, channel_id), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
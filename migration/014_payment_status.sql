@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN payment_intent_id VARCHAR(255) DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN payment_status VARCHAR(20) DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_payment_intent (payment_intent_id), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN tx_vout INT UNSIGNED DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN tx_confirmations INT UNSIGNED DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_fiat_timestamp (is_fiat, timestamp), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
@@ -0,0 +1,58 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE creator_stat (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ creator_channel_id CHAR(40) NOT NULL,
+ claim_id           CHAR(40) NOT NULL DEFAULT '',
+ bucket             DATETIME NOT NULL,
+ comments           BIGINT UNSIGNED NOT NULL DEFAULT 0,
+ flagged            BIGINT UNSIGNED NOT NULL DEFAULT 0,
+ blocks             BIGINT UNSIGNED NOT NULL DEFAULT 0,
+
+ PRIMARY KEY (id),
+ UNIQUE idx_creator_stat_bucket (creator_channel_id, bucket, claim_id)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE creator_stat_commenter (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ creator_channel_id CHAR(40) NOT NULL,
+ claim_id           CHAR(40) NOT NULL DEFAULT '',
+ bucket             DATETIME NOT NULL,
+ channel_id         CHAR(40) NOT NULL,
+
+ PRIMARY KEY (id),
+ UNIQUE idx_creator_stat_commenter_bucket (creator_channel_id, bucket, claim_id, channel_id)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE creator_stat_reaction (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ creator_channel_id CHAR(40) NOT NULL,
+ claim_id           CHAR(40) NOT NULL DEFAULT '',
+ bucket             DATETIME NOT NULL,
+ reaction_type      VARCHAR(255) NOT NULL,
+ count              BIGINT NOT NULL DEFAULT 0,
+
+ PRIMARY KEY (id),
+ UNIQUE idx_creator_stat_reaction_bucket (creator_channel_id, bucket, claim_id, reaction_type)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE creator_stat_tip (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ creator_channel_id CHAR(40) NOT NULL,
+ claim_id           CHAR(40) NOT NULL DEFAULT '',
+ bucket             DATETIME NOT NULL,
+ currency           VARCHAR(3) NOT NULL,
+ amount             BIGINT UNSIGNED NOT NULL DEFAULT 0,
+ count              BIGINT UNSIGNED NOT NULL DEFAULT 0,
+
+ PRIMARY KEY (id),
+ UNIQUE idx_creator_stat_tip_bucket (creator_channel_id, bucket, claim_id, currency)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
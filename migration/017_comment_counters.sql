@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN reply_count INT NOT NULL DEFAULT 0;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE comment_reaction_count (
+ comment_id       CHAR(64) NOT NULL,
+ reaction_type_id BIGINT UNSIGNED NOT NULL,
+ count            INT NOT NULL DEFAULT 0,
+
+ PRIMARY KEY (comment_id, reaction_type_id),
+ FOREIGN KEY (comment_id) REFERENCES comment (comment_id) ON DELETE CASCADE ON UPDATE CASCADE,
+ FOREIGN KEY (reaction_type_id) REFERENCES reaction_type (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE comment c
+    INNER JOIN (SELECT parent_id, COUNT(*) AS replies FROM comment WHERE parent_id IS NOT NULL GROUP BY parent_id) r
+    ON r.parent_id = c.comment_id
+SET c.reply_count = r.replies;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO comment_reaction_count (comment_id, reaction_type_id, count)
+SELECT comment_id, reaction_type_id, COUNT(*) FROM reaction GROUP BY comment_id, reaction_type_id;
+-- +migrate StatementEnd
@@ -0,0 +1,37 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN hot_score DOUBLE NOT NULL DEFAULT 0;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN best_score DOUBLE NOT NULL DEFAULT 0;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_hot (lbry_claim_id, hot_score, timestamp), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_best (lbry_claim_id, best_score, timestamp), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_recent (timestamp, comment_id), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
+
+-- Best scores do not decay so they are backfilled once, the Wilson lower bound of the likes at 95% confidence.
+-- +migrate StatementBegin
+UPDATE comment c
+    INNER JOIN (SELECT crc.comment_id,
+                       SUM(IF(rt.name = 'like', crc.count, 0)) AS likes,
+                       SUM(crc.count) AS total
+                FROM comment_reaction_count crc
+                    INNER JOIN reaction_type rt ON rt.id = crc.reaction_type_id
+                WHERE rt.name IN ('like', 'dislike')
+                GROUP BY crc.comment_id) s ON s.comment_id = c.comment_id
+SET c.best_score = (s.likes / s.total + 3.8416 / (2 * s.total)
+    - 1.96 * SQRT(((s.likes / s.total) * (1 - s.likes / s.total) + 3.8416 / (4 * s.total)) / s.total))
+    / (1 + 3.8416 / s.total)
+WHERE s.total > 0;
+-- +migrate StatementEnd
@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE reaction_type ADD COLUMN is_global BOOL NOT NULL DEFAULT FALSE;
+-- +migrate StatementEnd
+
+-- Only the reaction types of the apps are on the allowlist, global moderators add others with reaction.ManageType.
+-- The other types created so far stay registered but are no longer allowed.
+-- +migrate StatementBegin
+INSERT INTO reaction_type (name, is_global) VALUES ('like', TRUE), ('dislike', TRUE) ON DUPLICATE KEY UPDATE is_global = TRUE;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE creator_reaction_type (
+ creator_channel_id CHAR(40) NOT NULL,
+ reaction_type_id   BIGINT UNSIGNED NOT NULL,
+
+ PRIMARY KEY (creator_channel_id, reaction_type_id),
+ FOREIGN KEY (creator_channel_id) REFERENCES channel (claim_id) ON DELETE CASCADE ON UPDATE CASCADE,
+ FOREIGN KEY (reaction_type_id) REFERENCES reaction_type (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
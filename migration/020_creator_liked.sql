@@ -0,0 +1,12 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE comment ADD COLUMN creator_liked_by CHAR(40) DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE comment c
+    INNER JOIN reaction r ON r.comment_id = c.comment_id AND r.channel_id = c.creator_channel_id
+    INNER JOIN reaction_type rt ON rt.id = r.reaction_type_id AND rt.name = 'like'
+SET c.creator_liked_by = r.channel_id;
+-- +migrate StatementEnd
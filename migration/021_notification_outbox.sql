@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE notification_outbox (
+ id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ payload          TEXT NOT NULL,
+ attempts         INT NOT NULL DEFAULT 0,
+ next_attempt_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ last_error       TEXT DEFAULT NULL,
+ dead_lettered_at DATETIME DEFAULT NULL,
+ created_at       DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ INDEX idx_notification_outbox_due (dead_lettered_at, next_attempt_at)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
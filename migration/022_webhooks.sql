@@ -0,0 +1,40 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE webhook (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ -- NULL for the webhooks of admins, which receive the events of every claim
+ creator_channel_id CHAR(40) DEFAULT NULL,
+ url                VARCHAR(2048) NOT NULL,
+ secret             CHAR(64) NOT NULL,
+ events             VARCHAR(255) NOT NULL,
+ created_at         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ updated_at         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ INDEX idx_webhook_creator (creator_channel_id),
+ FOREIGN KEY fk_webhook_creator (creator_channel_id) REFERENCES channel (claim_id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE webhook_delivery (
+ id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ webhook_id       BIGINT UNSIGNED NOT NULL,
+ event            VARCHAR(32) NOT NULL,
+ payload          TEXT NOT NULL,
+ attempts         INT NOT NULL DEFAULT 0,
+ next_attempt_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ status_code      INT DEFAULT NULL,
+ last_error       TEXT DEFAULT NULL,
+ delivered_at     DATETIME DEFAULT NULL,
+ dead_lettered_at DATETIME DEFAULT NULL,
+ created_at       DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ INDEX idx_webhook_delivery_due (delivered_at, dead_lettered_at, next_attempt_at),
+ INDEX idx_webhook_delivery_log (webhook_id, id),
+ INDEX idx_webhook_delivery_created (created_at),
+ FOREIGN KEY fk_webhook_delivery_webhook (webhook_id) REFERENCES webhook (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
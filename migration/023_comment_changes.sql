@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE comment_change (
+ seq        BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ kind       VARCHAR(16) NOT NULL,
+ comment_id CHAR(64) DEFAULT NULL,
+ claim_id   CHAR(40) DEFAULT NULL,
+ channel_id CHAR(40) DEFAULT NULL,
+ data       TEXT DEFAULT NULL,
+ created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (seq)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE api_key (
+ id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ name         VARCHAR(64) NOT NULL,
+ -- sha256 of the key, the key itself is only shown when it is created
+ key_hash     CHAR(64) NOT NULL,
+ -- comma separated scopes the key gives access to
+ scopes       VARCHAR(255) NOT NULL,
+ -- requests allowed per minute
+ rate_limit   INT NOT NULL DEFAULT 600,
+ last_used_at DATETIME DEFAULT NULL,
+ revoked_at   DATETIME DEFAULT NULL,
+ created_at   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ updated_at   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ UNIQUE KEY idx_api_key_name (name),
+ UNIQUE KEY idx_api_key_hash (key_hash)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
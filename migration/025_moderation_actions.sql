@@ -0,0 +1,23 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE moderation_action (
+ id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ action             VARCHAR(32) NOT NULL,
+ -- the channel that did it, the creator or one of their delegates, or a global moderator
+ actor_channel_id   CHAR(40) NOT NULL,
+ -- the creator the action was done for, NULL for global actions like universal blocks
+ creator_channel_id CHAR(40) DEFAULT NULL,
+ target_channel_id  CHAR(40) DEFAULT NULL,
+ claim_id           CHAR(40) DEFAULT NULL,
+ comment_id         CHAR(64) DEFAULT NULL,
+ before_json        TEXT DEFAULT NULL,
+ after_json         TEXT DEFAULT NULL,
+ reason             VARCHAR(500) DEFAULT NULL,
+ created_at         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ INDEX idx_moderation_action_creator (creator_channel_id, id),
+ INDEX idx_moderation_action_actor (actor_channel_id, id)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
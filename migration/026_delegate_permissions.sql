@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- permissons is a bitmask of commentapi.Permission*, delegates added before it was enforced could do everything
+-- +migrate StatementBegin
+UPDATE delegated_moderator SET permissons = 255 WHERE permissons = 0;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE delegated_moderator ALTER COLUMN permissons SET DEFAULT 255;
+-- +migrate StatementEnd
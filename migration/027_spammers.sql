@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- channels whose comments or reactions are flagged, managed by level 3 global moderators
+-- +migrate StatementBegin
+CREATE TABLE spammer (
+ id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ channel_id CHAR(40) NOT NULL,
+ -- comment or reaction
+ kind       VARCHAR(16) NOT NULL,
+ created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+ PRIMARY KEY (id),
+ UNIQUE KEY idx_spammer (channel_id, kind)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
+
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- delegates are invited and become active once the invited channel accepts, existing delegates stay active
+-- +migrate StatementBegin
+ALTER TABLE delegated_moderator
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active',
+    ADD COLUMN invite_expires_at DATETIME DEFAULT NULL,
+    ADD INDEX idx_mod_status (mod_channel_id, status);
+-- +migrate StatementEnd
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- delegates can be limited to some claims of the creator and for a limited time
+-- +migrate StatementBegin
+ALTER TABLE delegated_moderator
+    -- comma separated claim ids, all claims of the creator when null
+    ADD COLUMN claim_scope TEXT DEFAULT NULL,
+    ADD COLUMN expires_at DATETIME DEFAULT NULL;
+-- +migrate StatementEnd
@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- the ticker expiry job looks up hyperchats by when they fall off the ticker across all claims
+-- +migrate StatementBegin
+ALTER TABLE comment ADD INDEX idx_comment_pinned_until (pinned_until), ALGORITHM=INPLACE, LOCK=NONE;
+-- +migrate StatementEnd
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- the creator that registered a custom reaction type, creators can only register a limited number of them
+-- +migrate StatementBegin
+ALTER TABLE reaction_type
+    ADD COLUMN created_by CHAR(40) DEFAULT NULL,
+    ADD INDEX idx_reaction_type_created_by (created_by);
+-- +migrate StatementEnd
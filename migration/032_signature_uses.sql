@@ -0,0 +1,18 @@
+-- +migrate Up
+
+-- signatures used within the signing window, shared by the instances so a signature cannot be replayed on another one
+-- +migrate StatementBegin
+CREATE TABLE signature_use (
+ id          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+ channel_id  CHAR(40) NOT NULL,
+ -- the R half of the signature, the S half can be negated to get another valid signature
+ signature_r CHAR(64) NOT NULL,
+ -- the rpc method the signature was used for
+ method      VARCHAR(64) NOT NULL,
+ expires_at  DATETIME NOT NULL,
+
+ PRIMARY KEY (id),
+ UNIQUE KEY idx_signature_use (channel_id, signature_r),
+ INDEX idx_signature_use_expires_at (expires_at)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- the name of the api key that made the action on behalf of the actor channel, without its signature
+-- +migrate StatementBegin
+ALTER TABLE moderation_action
+    ADD COLUMN api_key VARCHAR(64) DEFAULT NULL;
+-- +migrate StatementEnd
@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- the block permission includes the timeout permission, which only allows the blocks that expire
+-- +migrate StatementBegin
+UPDATE delegated_moderator SET permissons = permissons | 2 WHERE permissons & 1 = 1;
+-- +migrate StatementEnd
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- deleting all the comments of a channel when blocking it now needs ModLevelDeleteAll, the global moderators that
+-- could already do it keep the permission
+-- +migrate StatementBegin
+UPDATE moderator SET mod_level = 2 WHERE mod_level = 1;
+-- +migrate StatementEnd
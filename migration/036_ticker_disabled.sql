@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- set when the creator saved an empty list of ticker tiers, the defaults are not used for them
+-- +migrate StatementBegin
+ALTER TABLE creator_setting ADD COLUMN ticker_disabled BOOLEAN DEFAULT NULL;
+-- +migrate StatementEnd
@@ -4,29 +4,59 @@
 package model
 
 var TableNames = struct {
-	BlockedEntry       string
-	BlockedList        string
-	BlockedListAppeal  string
-	BlockedListInvite  string
-	Channel            string
-	Comment            string
-	CreatorSetting     string
-	DelegatedModerator string
-	GorpMigrations     string
-	Moderator          string
-	Reaction           string
-	ReactionType       string
+	APIKey               string
+	BlockedEntry         string
+	BlockedList          string
+	BlockedListAppeal    string
+	BlockedListInvite    string
+	Channel              string
+	Comment              string
+	CommentChange        string
+	CommentReactionCount string
+	CreatorReactionType  string
+	CreatorSetting       string
+	CreatorStat          string
+	CreatorStatCommenter string
+	CreatorStatReaction  string
+	CreatorStatTip       string
+	DelegatedModerator   string
+	GorpMigrations       string
+	ModerationAction     string
+	Moderator            string
+	NotificationOutbox   string
+	Reaction             string
+	ReactionType         string
+	SignatureUse         string
+	Spammer              string
+	TickerTier           string
+	Webhook              string
+	WebhookDelivery      string
 }{
-	BlockedEntry:       "blocked_entry",
-	BlockedList:        "blocked_list",
-	BlockedListAppeal:  "blocked_list_appeal",
-	BlockedListInvite:  "blocked_list_invite",
-	Channel:            "channel",
-	Comment:            "comment",
-	CreatorSetting:     "creator_setting",
-	DelegatedModerator: "delegated_moderator",
-	GorpMigrations:     "gorp_migrations",
-	Moderator:          "moderator",
-	Reaction:           "reaction",
-	ReactionType:       "reaction_type",
+	APIKey:               "api_key",
+	BlockedEntry:         "blocked_entry",
+	BlockedList:          "blocked_list",
+	BlockedListAppeal:    "blocked_list_appeal",
+	BlockedListInvite:    "blocked_list_invite",
+	Channel:              "channel",
+	Comment:              "comment",
+	CommentChange:        "comment_change",
+	CommentReactionCount: "comment_reaction_count",
+	CreatorReactionType:  "creator_reaction_type",
+	CreatorSetting:       "creator_setting",
+	CreatorStat:          "creator_stat",
+	CreatorStatCommenter: "creator_stat_commenter",
+	CreatorStatReaction:  "creator_stat_reaction",
+	CreatorStatTip:       "creator_stat_tip",
+	DelegatedModerator:   "delegated_moderator",
+	GorpMigrations:       "gorp_migrations",
+	ModerationAction:     "moderation_action",
+	Moderator:            "moderator",
+	NotificationOutbox:   "notification_outbox",
+	Reaction:             "reaction",
+	ReactionType:         "reaction_type",
+	SignatureUse:         "signature_use",
+	Spammer:              "spammer",
+	TickerTier:           "ticker_tier",
+	Webhook:              "webhook",
+	WebhookDelivery:      "webhook_delivery",
 }
@@ -74,11 +74,14 @@ var ChannelRels = struct {
 	InviterChannelBlockedListInvites        string
 	InvitedChannelBlockedListInvites        string
 	Comments                                string
+	ReactionTypes                           string
 	CreatorChannelCreatorSettings           string
 	ModChannelDelegatedModerators           string
 	CreatorChannelDelegatedModerators       string
 	ModChannelModerators                    string
 	Reactions                               string
+	CreatorChannelTickerTiers               string
+	CreatorChannelWebhooks                  string
 }{
 	BlockedListInvite:                       "BlockedListInvite",
 	BlockedList:                             "BlockedList",
@@ -89,11 +92,14 @@ var ChannelRels = struct {
 	InviterChannelBlockedListInvites:        "InviterChannelBlockedListInvites",
 	InvitedChannelBlockedListInvites:        "InvitedChannelBlockedListInvites",
 	Comments:                                "Comments",
+	ReactionTypes:                           "ReactionTypes",
 	CreatorChannelCreatorSettings:           "CreatorChannelCreatorSettings",
 	ModChannelDelegatedModerators:           "ModChannelDelegatedModerators",
 	CreatorChannelDelegatedModerators:       "CreatorChannelDelegatedModerators",
 	ModChannelModerators:                    "ModChannelModerators",
 	Reactions:                               "Reactions",
+	CreatorChannelTickerTiers:               "CreatorChannelTickerTiers",
+	CreatorChannelWebhooks:                  "CreatorChannelWebhooks",
 }
 
 // channelR is where relationships are stored.
@@ -107,11 +113,14 @@ type channelR struct {
 	InviterChannelBlockedListInvites        BlockedListInviteSlice
 	InvitedChannelBlockedListInvites        BlockedListInviteSlice
 	Comments                                CommentSlice
+	ReactionTypes                           ReactionTypeSlice
 	CreatorChannelCreatorSettings           CreatorSettingSlice
 	ModChannelDelegatedModerators           DelegatedModeratorSlice
 	CreatorChannelDelegatedModerators       DelegatedModeratorSlice
 	ModChannelModerators                    ModeratorSlice
 	Reactions                               ReactionSlice
+	CreatorChannelTickerTiers               TickerTierSlice
+	CreatorChannelWebhooks                  WebhookSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -395,6 +404,28 @@ func (o *Channel) Comments(mods ...qm.QueryMod) commentQuery {
 	return query
 }
 
+// ReactionTypes retrieves all the reaction_type's ReactionTypes with an executor.
+func (o *Channel) ReactionTypes(mods ...qm.QueryMod) reactionTypeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("`creator_reaction_type` on `reaction_type`.`id` = `creator_reaction_type`.`reaction_type_id`"),
+		qm.Where("`creator_reaction_type`.`creator_channel_id`=?", o.ClaimID),
+	)
+
+	query := ReactionTypes(queryMods...)
+	queries.SetFrom(query.Query, "`reaction_type`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`reaction_type`.*"})
+	}
+
+	return query
+}
+
 // CreatorChannelCreatorSettings retrieves all the creator_setting's CreatorSettings with an executor via creator_channel_id column.
 func (o *Channel) CreatorChannelCreatorSettings(mods ...qm.QueryMod) creatorSettingQuery {
 	var queryMods []qm.QueryMod
@@ -500,6 +531,48 @@ func (o *Channel) Reactions(mods ...qm.QueryMod) reactionQuery {
 	return query
 }
 
+// CreatorChannelTickerTiers retrieves all the ticker_tier's TickerTiers with an executor via creator_channel_id column.
+func (o *Channel) CreatorChannelTickerTiers(mods ...qm.QueryMod) tickerTierQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`ticker_tier`.`creator_channel_id`=?", o.ClaimID),
+	)
+
+	query := TickerTiers(queryMods...)
+	queries.SetFrom(query.Query, "`ticker_tier`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`ticker_tier`.*"})
+	}
+
+	return query
+}
+
+// CreatorChannelWebhooks retrieves all the webhook's Webhooks with an executor via creator_channel_id column.
+func (o *Channel) CreatorChannelWebhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`webhook`.`creator_channel_id`=?", o.ClaimID),
+	)
+
+	query := Webhooks(queryMods...)
+	queries.SetFrom(query.Query, "`webhook`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`webhook`.*"})
+	}
+
+	return query
+}
+
 // LoadBlockedListInvite allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (channelL) LoadBlockedListInvite(e boil.Executor, singular bool, maybeChannel interface{}, mods queries.Applicator) error {
@@ -1310,6 +1383,114 @@ func (channelL) LoadComments(e boil.Executor, singular bool, maybeChannel interf
 	return nil
 }
 
+// LoadReactionTypes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (channelL) LoadReactionTypes(e boil.Executor, singular bool, maybeChannel interface{}, mods queries.Applicator) error {
+	var slice []*Channel
+	var object *Channel
+
+	if singular {
+		object = maybeChannel.(*Channel)
+	} else {
+		slice = *maybeChannel.(*[]*Channel)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &channelR{}
+		}
+		args = append(args, object.ClaimID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &channelR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ClaimID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClaimID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("`reaction_type`.*, `a`.`creator_channel_id`"),
+		qm.From("`reaction_type`"),
+		qm.InnerJoin("`creator_reaction_type` as `a` on `reaction_type`.`id` = `a`.`reaction_type_id`"),
+		qm.WhereIn("`a`.`creator_channel_id` in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reaction_type")
+	}
+
+	var resultSlice []*ReactionType
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(ReactionType)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.UpdatedAt, &one.IsGlobal, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for reaction_type")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice reaction_type")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reaction_type")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reaction_type")
+	}
+
+	if singular {
+		object.R.ReactionTypes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reactionTypeR{}
+			}
+			foreign.R.CreatorChannelChannels = append(foreign.R.CreatorChannelChannels, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ClaimID == localJoinCol {
+				local.R.ReactionTypes = append(local.R.ReactionTypes, foreign)
+				if foreign.R == nil {
+					foreign.R = &reactionTypeR{}
+				}
+				foreign.R.CreatorChannelChannels = append(foreign.R.CreatorChannelChannels, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCreatorChannelCreatorSettings allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (channelL) LoadCreatorChannelCreatorSettings(e boil.Executor, singular bool, maybeChannel interface{}, mods queries.Applicator) error {
@@ -1750,125 +1931,301 @@ func (channelL) LoadReactions(e boil.Executor, singular bool, maybeChannel inter
 	return nil
 }
 
-// SetBlockedListInvite of the channel to the related item.
-// Sets o.R.BlockedListInvite to related.
-// Adds o to related.R.BlockedListInviteChannels.
-func (o *Channel) SetBlockedListInvite(exec boil.Executor, insert bool, related *BlockedList) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `channel` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"blocked_list_invite_id"}),
-		strmangle.WhereClause("`", "`", 0, channelPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ClaimID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadCreatorChannelTickerTiers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (channelL) LoadCreatorChannelTickerTiers(e boil.Executor, singular bool, maybeChannel interface{}, mods queries.Applicator) error {
+	var slice []*Channel
+	var object *Channel
 
-	queries.Assign(&o.BlockedListInviteID, related.ID)
-	if o.R == nil {
-		o.R = &channelR{
-			BlockedListInvite: related,
-		}
+	if singular {
+		object = maybeChannel.(*Channel)
 	} else {
-		o.R.BlockedListInvite = related
+		slice = *maybeChannel.(*[]*Channel)
 	}
 
-	if related.R == nil {
-		related.R = &blockedListR{
-			BlockedListInviteChannels: ChannelSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &channelR{}
 		}
+		args = append(args, object.ClaimID)
 	} else {
-		related.R.BlockedListInviteChannels = append(related.R.BlockedListInviteChannels, o)
-	}
-
-	return nil
-}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &channelR{}
+			}
 
-// RemoveBlockedListInvite relationship.
-// Sets o.R.BlockedListInvite to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *Channel) RemoveBlockedListInvite(exec boil.Executor, related *BlockedList) error {
-	var err error
+			for _, a := range args {
+				if a == obj.ClaimID {
+					continue Outer
+				}
+			}
 
-	queries.SetScanner(&o.BlockedListInviteID, nil)
-	if err = o.Update(exec, boil.Whitelist("blocked_list_invite_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			args = append(args, obj.ClaimID)
+		}
 	}
 
-	o.R.BlockedListInvite = nil
-	if related == nil || related.R == nil {
+	if len(args) == 0 {
 		return nil
 	}
 
-	for i, ri := range related.R.BlockedListInviteChannels {
-		if queries.Equal(o.BlockedListInviteID, ri.BlockedListInviteID) {
-			continue
-		}
-
-		ln := len(related.R.BlockedListInviteChannels)
-		if ln > 1 && i < ln-1 {
-			related.R.BlockedListInviteChannels[i] = related.R.BlockedListInviteChannels[ln-1]
-		}
-		related.R.BlockedListInviteChannels = related.R.BlockedListInviteChannels[:ln-1]
-		break
+	query := NewQuery(qm.From(`ticker_tier`), qm.WhereIn(`creator_channel_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// SetBlockedList of the channel to the related item.
-// Sets o.R.BlockedList to related.
-// Adds o to related.R.Channels.
-func (o *Channel) SetBlockedList(exec boil.Executor, insert bool, related *BlockedList) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ticker_tier")
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `channel` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"blocked_list_id"}),
-		strmangle.WhereClause("`", "`", 0, channelPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ClaimID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+	var resultSlice []*TickerTier
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ticker_tier")
 	}
 
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ticker_tier")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ticker_tier")
 	}
 
-	queries.Assign(&o.BlockedListID, related.ID)
-	if o.R == nil {
-		o.R = &channelR{
-			BlockedList: related,
+	if singular {
+		object.R.CreatorChannelTickerTiers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tickerTierR{}
+			}
+			foreign.R.CreatorChannel = object
 		}
-	} else {
-		o.R.BlockedList = related
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &blockedListR{
-			Channels: ChannelSlice{o},
-		}
-	} else {
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ClaimID == foreign.CreatorChannelID {
+				local.R.CreatorChannelTickerTiers = append(local.R.CreatorChannelTickerTiers, foreign)
+				if foreign.R == nil {
+					foreign.R = &tickerTierR{}
+				}
+				foreign.R.CreatorChannel = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatorChannelWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (channelL) LoadCreatorChannelWebhooks(e boil.Executor, singular bool, maybeChannel interface{}, mods queries.Applicator) error {
+	var slice []*Channel
+	var object *Channel
+
+	if singular {
+		object = maybeChannel.(*Channel)
+	} else {
+		slice = *maybeChannel.(*[]*Channel)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &channelR{}
+		}
+		args = append(args, object.ClaimID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &channelR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ClaimID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClaimID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`webhook`), qm.WhereIn(`creator_channel_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook")
+	}
+
+	if singular {
+		object.R.CreatorChannelWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.CreatorChannel = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ClaimID, foreign.CreatorChannelID) {
+				local.R.CreatorChannelWebhooks = append(local.R.CreatorChannelWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.CreatorChannel = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBlockedListInvite of the channel to the related item.
+// Sets o.R.BlockedListInvite to related.
+// Adds o to related.R.BlockedListInviteChannels.
+func (o *Channel) SetBlockedListInvite(exec boil.Executor, insert bool, related *BlockedList) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `channel` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"blocked_list_invite_id"}),
+		strmangle.WhereClause("`", "`", 0, channelPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClaimID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BlockedListInviteID, related.ID)
+	if o.R == nil {
+		o.R = &channelR{
+			BlockedListInvite: related,
+		}
+	} else {
+		o.R.BlockedListInvite = related
+	}
+
+	if related.R == nil {
+		related.R = &blockedListR{
+			BlockedListInviteChannels: ChannelSlice{o},
+		}
+	} else {
+		related.R.BlockedListInviteChannels = append(related.R.BlockedListInviteChannels, o)
+	}
+
+	return nil
+}
+
+// RemoveBlockedListInvite relationship.
+// Sets o.R.BlockedListInvite to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Channel) RemoveBlockedListInvite(exec boil.Executor, related *BlockedList) error {
+	var err error
+
+	queries.SetScanner(&o.BlockedListInviteID, nil)
+	if err = o.Update(exec, boil.Whitelist("blocked_list_invite_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.BlockedListInvite = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.BlockedListInviteChannels {
+		if queries.Equal(o.BlockedListInviteID, ri.BlockedListInviteID) {
+			continue
+		}
+
+		ln := len(related.R.BlockedListInviteChannels)
+		if ln > 1 && i < ln-1 {
+			related.R.BlockedListInviteChannels[i] = related.R.BlockedListInviteChannels[ln-1]
+		}
+		related.R.BlockedListInviteChannels = related.R.BlockedListInviteChannels[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetBlockedList of the channel to the related item.
+// Sets o.R.BlockedList to related.
+// Adds o to related.R.Channels.
+func (o *Channel) SetBlockedList(exec boil.Executor, insert bool, related *BlockedList) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `channel` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"blocked_list_id"}),
+		strmangle.WhereClause("`", "`", 0, channelPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClaimID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BlockedListID, related.ID)
+	if o.R == nil {
+		o.R = &channelR{
+			BlockedList: related,
+		}
+	} else {
+		o.R.BlockedList = related
+	}
+
+	if related.R == nil {
+		related.R = &blockedListR{
+			Channels: ChannelSlice{o},
+		}
+	} else {
 		related.R.Channels = append(related.R.Channels, o)
 	}
 
@@ -2557,6 +2914,146 @@ func (o *Channel) RemoveComments(exec boil.Executor, related ...*Comment) error
 	return nil
 }
 
+// AddReactionTypes adds the given related objects to the existing relationships
+// of the channel, optionally inserting them as new records.
+// Appends related to o.R.ReactionTypes.
+// Sets related.R.CreatorChannelChannels appropriately.
+func (o *Channel) AddReactionTypes(exec boil.Executor, insert bool, related ...*ReactionType) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `creator_reaction_type` (`creator_channel_id`, `reaction_type_id`) values (?, ?)"
+		values := []interface{}{o.ClaimID, rel.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		_, err = exec.Exec(query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &channelR{
+			ReactionTypes: related,
+		}
+	} else {
+		o.R.ReactionTypes = append(o.R.ReactionTypes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reactionTypeR{
+				CreatorChannelChannels: ChannelSlice{o},
+			}
+		} else {
+			rel.R.CreatorChannelChannels = append(rel.R.CreatorChannelChannels, o)
+		}
+	}
+	return nil
+}
+
+// SetReactionTypes removes all previously related items of the
+// channel replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatorChannelChannels's ReactionTypes accordingly.
+// Replaces o.R.ReactionTypes with related.
+// Sets related.R.CreatorChannelChannels's ReactionTypes accordingly.
+func (o *Channel) SetReactionTypes(exec boil.Executor, insert bool, related ...*ReactionType) error {
+	query := "delete from `creator_reaction_type` where `creator_channel_id` = ?"
+	values := []interface{}{o.ClaimID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeReactionTypesFromCreatorChannelChannelsSlice(o, related)
+	if o.R != nil {
+		o.R.ReactionTypes = nil
+	}
+	return o.AddReactionTypes(exec, insert, related...)
+}
+
+// RemoveReactionTypes relationships from objects passed in.
+// Removes related items from R.ReactionTypes (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatorChannelChannels.
+func (o *Channel) RemoveReactionTypes(exec boil.Executor, related ...*ReactionType) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from `creator_reaction_type` where `creator_channel_id` = ? and `reaction_type_id` in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ClaimID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err = exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeReactionTypesFromCreatorChannelChannelsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReactionTypes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReactionTypes)
+			if ln > 1 && i < ln-1 {
+				o.R.ReactionTypes[i] = o.R.ReactionTypes[ln-1]
+			}
+			o.R.ReactionTypes = o.R.ReactionTypes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeReactionTypesFromCreatorChannelChannelsSlice(o *Channel, related []*ReactionType) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.CreatorChannelChannels {
+			if o.ClaimID != ri.ClaimID {
+				continue
+			}
+
+			ln := len(rel.R.CreatorChannelChannels)
+			if ln > 1 && i < ln-1 {
+				rel.R.CreatorChannelChannels[i] = rel.R.CreatorChannelChannels[ln-1]
+			}
+			rel.R.CreatorChannelChannels = rel.R.CreatorChannelChannels[:ln-1]
+			break
+		}
+	}
+}
+
 // AddCreatorChannelCreatorSettings adds the given related objects to the existing relationships
 // of the channel, optionally inserting them as new records.
 // Appends related to o.R.CreatorChannelCreatorSettings.
@@ -2962,6 +3459,182 @@ func (o *Channel) RemoveReactions(exec boil.Executor, related ...*Reaction) erro
 	return nil
 }
 
+// AddCreatorChannelTickerTiers adds the given related objects to the existing relationships
+// of the channel, optionally inserting them as new records.
+// Appends related to o.R.CreatorChannelTickerTiers.
+// Sets related.R.CreatorChannel appropriately.
+func (o *Channel) AddCreatorChannelTickerTiers(exec boil.Executor, insert bool, related ...*TickerTier) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatorChannelID = o.ClaimID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `ticker_tier` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"creator_channel_id"}),
+				strmangle.WhereClause("`", "`", 0, tickerTierPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ClaimID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatorChannelID = o.ClaimID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &channelR{
+			CreatorChannelTickerTiers: related,
+		}
+	} else {
+		o.R.CreatorChannelTickerTiers = append(o.R.CreatorChannelTickerTiers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tickerTierR{
+				CreatorChannel: o,
+			}
+		} else {
+			rel.R.CreatorChannel = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorChannelWebhooks adds the given related objects to the existing relationships
+// of the channel, optionally inserting them as new records.
+// Appends related to o.R.CreatorChannelWebhooks.
+// Sets related.R.CreatorChannel appropriately.
+func (o *Channel) AddCreatorChannelWebhooks(exec boil.Executor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatorChannelID, o.ClaimID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `webhook` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"creator_channel_id"}),
+				strmangle.WhereClause("`", "`", 0, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ClaimID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatorChannelID, o.ClaimID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &channelR{
+			CreatorChannelWebhooks: related,
+		}
+	} else {
+		o.R.CreatorChannelWebhooks = append(o.R.CreatorChannelWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				CreatorChannel: o,
+			}
+		} else {
+			rel.R.CreatorChannel = o
+		}
+	}
+	return nil
+}
+
+// SetCreatorChannelWebhooks removes all previously related items of the
+// channel replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatorChannel's CreatorChannelWebhooks accordingly.
+// Replaces o.R.CreatorChannelWebhooks with related.
+// Sets related.R.CreatorChannel's CreatorChannelWebhooks accordingly.
+func (o *Channel) SetCreatorChannelWebhooks(exec boil.Executor, insert bool, related ...*Webhook) error {
+	query := "update `webhook` set `creator_channel_id` = null where `creator_channel_id` = ?"
+	values := []interface{}{o.ClaimID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatorChannelWebhooks {
+			queries.SetScanner(&rel.CreatorChannelID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatorChannel = nil
+		}
+
+		o.R.CreatorChannelWebhooks = nil
+	}
+	return o.AddCreatorChannelWebhooks(exec, insert, related...)
+}
+
+// RemoveCreatorChannelWebhooks relationships from objects passed in.
+// Removes related items from R.CreatorChannelWebhooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatorChannel.
+func (o *Channel) RemoveCreatorChannelWebhooks(exec boil.Executor, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatorChannelID, nil)
+		if rel.R != nil {
+			rel.R.CreatorChannel = nil
+		}
+		if err = rel.Update(exec, boil.Whitelist("creator_channel_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatorChannelWebhooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatorChannelWebhooks)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatorChannelWebhooks[i] = o.R.CreatorChannelWebhooks[ln-1]
+			}
+			o.R.CreatorChannelWebhooks = o.R.CreatorChannelWebhooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Channels retrieves all the records using an executor.
 func Channels(mods ...qm.QueryMod) channelQuery {
 	mods = append(mods, qm.From("`channel`"))
@@ -40,6 +40,16 @@ type Comment struct {
 	ControversyScore null.Int    `boil:"controversy_score" json:"controversy_score,omitempty" toml:"controversy_score" yaml:"controversy_score,omitempty"`
 	IsFiat           bool        `boil:"is_fiat" json:"is_fiat" toml:"is_fiat" yaml:"is_fiat"`
 	Currency         null.String `boil:"currency" json:"currency,omitempty" toml:"currency" yaml:"currency,omitempty"`
+	PinnedUntil      null.Uint64 `boil:"pinned_until" json:"pinned_until,omitempty" toml:"pinned_until" yaml:"pinned_until,omitempty"`
+	CreatorChannelID null.String `boil:"creator_channel_id" json:"creator_channel_id,omitempty" toml:"creator_channel_id" yaml:"creator_channel_id,omitempty"`
+	PaymentIntentID  null.String `boil:"payment_intent_id" json:"payment_intent_id,omitempty" toml:"payment_intent_id" yaml:"payment_intent_id,omitempty"`
+	PaymentStatus    null.String `boil:"payment_status" json:"payment_status,omitempty" toml:"payment_status" yaml:"payment_status,omitempty"`
+	TXVout           null.Uint   `boil:"tx_vout" json:"tx_vout,omitempty" toml:"tx_vout" yaml:"tx_vout,omitempty"`
+	TXConfirmations  null.Uint   `boil:"tx_confirmations" json:"tx_confirmations,omitempty" toml:"tx_confirmations" yaml:"tx_confirmations,omitempty"`
+	ReplyCount       int         `boil:"reply_count" json:"reply_count" toml:"reply_count" yaml:"reply_count"`
+	HotScore         float64     `boil:"hot_score" json:"hot_score" toml:"hot_score" yaml:"hot_score"`
+	BestScore        float64     `boil:"best_score" json:"best_score" toml:"best_score" yaml:"best_score"`
+	CreatorLikedBy   null.String `boil:"creator_liked_by" json:"creator_liked_by,omitempty" toml:"creator_liked_by" yaml:"creator_liked_by,omitempty"`
 
 	R *commentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L commentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -63,6 +73,16 @@ var CommentColumns = struct {
 	ControversyScore string
 	IsFiat           string
 	Currency         string
+	PinnedUntil      string
+	CreatorChannelID string
+	PaymentIntentID  string
+	PaymentStatus    string
+	TXVout           string
+	TXConfirmations  string
+	ReplyCount       string
+	HotScore         string
+	BestScore        string
+	CreatorLikedBy   string
 }{
 	CommentID:        "comment_id",
 	LbryClaimID:      "lbry_claim_id",
@@ -81,6 +101,16 @@ var CommentColumns = struct {
 	ControversyScore: "controversy_score",
 	IsFiat:           "is_fiat",
 	Currency:         "currency",
+	PinnedUntil:      "pinned_until",
+	CreatorChannelID: "creator_channel_id",
+	PaymentIntentID:  "payment_intent_id",
+	PaymentStatus:    "payment_status",
+	TXVout:           "tx_vout",
+	TXConfirmations:  "tx_confirmations",
+	ReplyCount:       "reply_count",
+	HotScore:         "hot_score",
+	BestScore:        "best_score",
+	CreatorLikedBy:   "creator_liked_by",
 }
 
 // Generated where
@@ -103,6 +133,44 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
+type whereHelpernull_Uint struct{ field string }
+
+func (w whereHelpernull_Uint) EQ(x null.Uint) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Uint) NEQ(x null.Uint) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Uint) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Uint) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Uint) LT(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Uint) LTE(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Uint) GT(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Uint) GTE(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var CommentWhere = struct {
 	CommentID        whereHelperstring
 	LbryClaimID      whereHelperstring
@@ -121,6 +189,16 @@ var CommentWhere = struct {
 	ControversyScore whereHelpernull_Int
 	IsFiat           whereHelperbool
 	Currency         whereHelpernull_String
+	PinnedUntil      whereHelpernull_Uint64
+	CreatorChannelID whereHelpernull_String
+	PaymentIntentID  whereHelpernull_String
+	PaymentStatus    whereHelpernull_String
+	TXVout           whereHelpernull_Uint
+	TXConfirmations  whereHelpernull_Uint
+	ReplyCount       whereHelperint
+	HotScore         whereHelperfloat64
+	BestScore        whereHelperfloat64
+	CreatorLikedBy   whereHelpernull_String
 }{
 	CommentID:        whereHelperstring{field: "`comment`.`comment_id`"},
 	LbryClaimID:      whereHelperstring{field: "`comment`.`lbry_claim_id`"},
@@ -139,6 +217,16 @@ var CommentWhere = struct {
 	ControversyScore: whereHelpernull_Int{field: "`comment`.`controversy_score`"},
 	IsFiat:           whereHelperbool{field: "`comment`.`is_fiat`"},
 	Currency:         whereHelpernull_String{field: "`comment`.`currency`"},
+	PinnedUntil:      whereHelpernull_Uint64{field: "`comment`.`pinned_until`"},
+	CreatorChannelID: whereHelpernull_String{field: "`comment`.`creator_channel_id`"},
+	PaymentIntentID:  whereHelpernull_String{field: "`comment`.`payment_intent_id`"},
+	PaymentStatus:    whereHelpernull_String{field: "`comment`.`payment_status`"},
+	TXVout:           whereHelpernull_Uint{field: "`comment`.`tx_vout`"},
+	TXConfirmations:  whereHelpernull_Uint{field: "`comment`.`tx_confirmations`"},
+	ReplyCount:       whereHelperint{field: "`comment`.`reply_count`"},
+	HotScore:         whereHelperfloat64{field: "`comment`.`hot_score`"},
+	BestScore:        whereHelperfloat64{field: "`comment`.`best_score`"},
+	CreatorLikedBy:   whereHelpernull_String{field: "`comment`.`creator_liked_by`"},
 }
 
 // CommentRels is where relationship names are stored.
@@ -147,12 +235,14 @@ var CommentRels = struct {
 	Parent                         string
 	OffendingCommentBlockedEntries string
 	ParentComments                 string
+	CommentReactionCounts          string
 	Reactions                      string
 }{
 	Channel:                        "Channel",
 	Parent:                         "Parent",
 	OffendingCommentBlockedEntries: "OffendingCommentBlockedEntries",
 	ParentComments:                 "ParentComments",
+	CommentReactionCounts:          "CommentReactionCounts",
 	Reactions:                      "Reactions",
 }
 
@@ -162,6 +252,7 @@ type commentR struct {
 	Parent                         *Comment
 	OffendingCommentBlockedEntries BlockedEntrySlice
 	ParentComments                 CommentSlice
+	CommentReactionCounts          CommentReactionCountSlice
 	Reactions                      ReactionSlice
 }
 
@@ -174,9 +265,9 @@ func (*commentR) NewStruct() *commentR {
 type commentL struct{}
 
 var (
-	commentAllColumns            = []string{"comment_id", "lbry_claim_id", "channel_id", "body", "parent_id", "signature", "signingts", "timestamp", "is_hidden", "is_pinned", "is_flagged", "amount", "tx_id", "popularity_score", "controversy_score", "is_fiat", "currency"}
-	commentColumnsWithoutDefault = []string{"comment_id", "lbry_claim_id", "channel_id", "body", "parent_id", "signature", "signingts", "timestamp", "amount", "tx_id", "popularity_score", "controversy_score", "currency"}
-	commentColumnsWithDefault    = []string{"is_hidden", "is_pinned", "is_flagged", "is_fiat"}
+	commentAllColumns            = []string{"comment_id", "lbry_claim_id", "channel_id", "body", "parent_id", "signature", "signingts", "timestamp", "is_hidden", "is_pinned", "is_flagged", "amount", "tx_id", "popularity_score", "controversy_score", "is_fiat", "currency", "pinned_until", "creator_channel_id", "payment_intent_id", "payment_status", "tx_vout", "tx_confirmations", "reply_count", "hot_score", "best_score", "creator_liked_by"}
+	commentColumnsWithoutDefault = []string{"comment_id", "lbry_claim_id", "channel_id", "body", "parent_id", "signature", "signingts", "timestamp", "amount", "tx_id", "popularity_score", "controversy_score", "currency", "pinned_until", "creator_channel_id", "payment_intent_id", "payment_status", "tx_vout", "tx_confirmations", "creator_liked_by"}
+	commentColumnsWithDefault    = []string{"is_hidden", "is_pinned", "is_flagged", "is_fiat", "reply_count", "hot_score", "best_score"}
 	commentPrimaryKeyColumns     = []string{"comment_id"}
 )
 
@@ -341,6 +432,27 @@ func (o *Comment) ParentComments(mods ...qm.QueryMod) commentQuery {
 	return query
 }
 
+// CommentReactionCounts retrieves all the comment_reaction_count's CommentReactionCounts with an executor.
+func (o *Comment) CommentReactionCounts(mods ...qm.QueryMod) commentReactionCountQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`comment_reaction_count`.`comment_id`=?", o.CommentID),
+	)
+
+	query := CommentReactionCounts(queryMods...)
+	queries.SetFrom(query.Query, "`comment_reaction_count`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`comment_reaction_count`.*"})
+	}
+
+	return query
+}
+
 // Reactions retrieves all the reaction's Reactions with an executor.
 func (o *Comment) Reactions(mods ...qm.QueryMod) reactionQuery {
 	var queryMods []qm.QueryMod
@@ -732,6 +844,94 @@ func (commentL) LoadParentComments(e boil.Executor, singular bool, maybeComment
 	return nil
 }
 
+// LoadCommentReactionCounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (commentL) LoadCommentReactionCounts(e boil.Executor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
+	var slice []*Comment
+	var object *Comment
+
+	if singular {
+		object = maybeComment.(*Comment)
+	} else {
+		slice = *maybeComment.(*[]*Comment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &commentR{}
+		}
+		args = append(args, object.CommentID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &commentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CommentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CommentID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`comment_reaction_count`), qm.WhereIn(`comment_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comment_reaction_count")
+	}
+
+	var resultSlice []*CommentReactionCount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comment_reaction_count")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comment_reaction_count")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_reaction_count")
+	}
+
+	if singular {
+		object.R.CommentReactionCounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentReactionCountR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.CommentID == foreign.CommentID {
+				local.R.CommentReactionCounts = append(local.R.CommentReactionCounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentReactionCountR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadReactions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (commentL) LoadReactions(e boil.Executor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
@@ -1222,6 +1422,59 @@ func (o *Comment) RemoveParentComments(exec boil.Executor, related ...*Comment)
 	return nil
 }
 
+// AddCommentReactionCounts adds the given related objects to the existing relationships
+// of the comment, optionally inserting them as new records.
+// Appends related to o.R.CommentReactionCounts.
+// Sets related.R.Comment appropriately.
+func (o *Comment) AddCommentReactionCounts(exec boil.Executor, insert bool, related ...*CommentReactionCount) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CommentID = o.CommentID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment_reaction_count` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+				strmangle.WhereClause("`", "`", 0, commentReactionCountPrimaryKeyColumns),
+			)
+			values := []interface{}{o.CommentID, rel.CommentID, rel.ReactionTypeID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CommentID = o.CommentID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &commentR{
+			CommentReactionCounts: related,
+		}
+	} else {
+		o.R.CommentReactionCounts = append(o.R.CommentReactionCounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentReactionCountR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
 // AddReactions adds the given related objects to the existing relationships
 // of the comment, optionally inserting them as new records.
 // Appends related to o.R.Reactions.
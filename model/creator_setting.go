@@ -34,6 +34,10 @@ type CreatorSetting struct {
 	SlowModeMinGap        null.Uint64 `boil:"slow_mode_min_gap" json:"slow_mode_min_gap,omitempty" toml:"slow_mode_min_gap" yaml:"slow_mode_min_gap,omitempty"`
 	CurseJarAmount        null.Uint64 `boil:"curse_jar_amount" json:"curse_jar_amount,omitempty" toml:"curse_jar_amount" yaml:"curse_jar_amount,omitempty"`
 	IsFiltersEnabled      null.Bool   `boil:"is_filters_enabled" json:"is_filters_enabled,omitempty" toml:"is_filters_enabled" yaml:"is_filters_enabled,omitempty"`
+	EmoteOnly             null.Bool   `boil:"emote_only" json:"emote_only,omitempty" toml:"emote_only" yaml:"emote_only,omitempty"`
+	SupportersOnly        null.Bool   `boil:"supporters_only" json:"supporters_only,omitempty" toml:"supporters_only" yaml:"supporters_only,omitempty"`
+	TimeSinceFirstComment null.Uint64 `boil:"time_since_first_comment" json:"time_since_first_comment,omitempty" toml:"time_since_first_comment" yaml:"time_since_first_comment,omitempty"`
+	TickerDisabled        null.Bool   `boil:"ticker_disabled" json:"ticker_disabled,omitempty" toml:"ticker_disabled" yaml:"ticker_disabled,omitempty"`
 
 	R *creatorSettingR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L creatorSettingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -51,6 +55,10 @@ var CreatorSettingColumns = struct {
 	SlowModeMinGap        string
 	CurseJarAmount        string
 	IsFiltersEnabled      string
+	EmoteOnly             string
+	SupportersOnly        string
+	TimeSinceFirstComment string
+	TickerDisabled        string
 }{
 	ID:                    "id",
 	CreatorChannelID:      "creator_channel_id",
@@ -63,6 +71,10 @@ var CreatorSettingColumns = struct {
 	SlowModeMinGap:        "slow_mode_min_gap",
 	CurseJarAmount:        "curse_jar_amount",
 	IsFiltersEnabled:      "is_filters_enabled",
+	EmoteOnly:             "emote_only",
+	SupportersOnly:        "supporters_only",
+	TimeSinceFirstComment: "time_since_first_comment",
+	TickerDisabled:        "ticker_disabled",
 }
 
 // Generated where
@@ -79,6 +91,10 @@ var CreatorSettingWhere = struct {
 	SlowModeMinGap        whereHelpernull_Uint64
 	CurseJarAmount        whereHelpernull_Uint64
 	IsFiltersEnabled      whereHelpernull_Bool
+	EmoteOnly             whereHelpernull_Bool
+	SupportersOnly        whereHelpernull_Bool
+	TimeSinceFirstComment whereHelpernull_Uint64
+	TickerDisabled        whereHelpernull_Bool
 }{
 	ID:                    whereHelperuint64{field: "`creator_setting`.`id`"},
 	CreatorChannelID:      whereHelperstring{field: "`creator_setting`.`creator_channel_id`"},
@@ -91,6 +107,10 @@ var CreatorSettingWhere = struct {
 	SlowModeMinGap:        whereHelpernull_Uint64{field: "`creator_setting`.`slow_mode_min_gap`"},
 	CurseJarAmount:        whereHelpernull_Uint64{field: "`creator_setting`.`curse_jar_amount`"},
 	IsFiltersEnabled:      whereHelpernull_Bool{field: "`creator_setting`.`is_filters_enabled`"},
+	EmoteOnly:             whereHelpernull_Bool{field: "`creator_setting`.`emote_only`"},
+	SupportersOnly:        whereHelpernull_Bool{field: "`creator_setting`.`supporters_only`"},
+	TimeSinceFirstComment: whereHelpernull_Uint64{field: "`creator_setting`.`time_since_first_comment`"},
+	TickerDisabled:        whereHelpernull_Bool{field: "`creator_setting`.`ticker_disabled`"},
 }
 
 // CreatorSettingRels is where relationship names are stored.
@@ -114,8 +134,8 @@ func (*creatorSettingR) NewStruct() *creatorSettingR {
 type creatorSettingL struct{}
 
 var (
-	creatorSettingAllColumns            = []string{"id", "creator_channel_id", "comments_enabled", "min_tip_amount_comment", "min_tip_amount_super_chat", "muted_words", "created_at", "updated_at", "slow_mode_min_gap", "curse_jar_amount", "is_filters_enabled"}
-	creatorSettingColumnsWithoutDefault = []string{"creator_channel_id", "min_tip_amount_comment", "min_tip_amount_super_chat", "muted_words", "slow_mode_min_gap", "curse_jar_amount", "is_filters_enabled"}
+	creatorSettingAllColumns            = []string{"id", "creator_channel_id", "comments_enabled", "min_tip_amount_comment", "min_tip_amount_super_chat", "muted_words", "created_at", "updated_at", "slow_mode_min_gap", "curse_jar_amount", "is_filters_enabled", "emote_only", "supporters_only", "time_since_first_comment", "ticker_disabled"}
+	creatorSettingColumnsWithoutDefault = []string{"creator_channel_id", "min_tip_amount_comment", "min_tip_amount_super_chat", "muted_words", "slow_mode_min_gap", "curse_jar_amount", "is_filters_enabled", "emote_only", "supporters_only", "time_since_first_comment", "ticker_disabled"}
 	creatorSettingColumnsWithDefault    = []string{"id", "comments_enabled", "created_at", "updated_at"}
 	creatorSettingPrimaryKeyColumns     = []string{"id"}
 )
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
 	"github.com/volatiletech/sqlboiler/queries"
 	"github.com/volatiletech/sqlboiler/queries/qm"
@@ -22,12 +23,16 @@ import (
 
 // DelegatedModerator is an object representing the database table.
 type DelegatedModerator struct {
-	ID               uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ModChannelID     string    `boil:"mod_channel_id" json:"mod_channel_id" toml:"mod_channel_id" yaml:"mod_channel_id"`
-	CreatorChannelID string    `boil:"creator_channel_id" json:"creator_channel_id" toml:"creator_channel_id" yaml:"creator_channel_id"`
-	Permissons       uint64    `boil:"permissons" json:"permissons" toml:"permissons" yaml:"permissons"`
-	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID               uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ModChannelID     string      `boil:"mod_channel_id" json:"mod_channel_id" toml:"mod_channel_id" yaml:"mod_channel_id"`
+	CreatorChannelID string      `boil:"creator_channel_id" json:"creator_channel_id" toml:"creator_channel_id" yaml:"creator_channel_id"`
+	Permissons       uint64      `boil:"permissons" json:"permissons" toml:"permissons" yaml:"permissons"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Status           string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	InviteExpiresAt  null.Time   `boil:"invite_expires_at" json:"invite_expires_at,omitempty" toml:"invite_expires_at" yaml:"invite_expires_at,omitempty"`
+	ClaimScope       null.String `boil:"claim_scope" json:"claim_scope,omitempty" toml:"claim_scope" yaml:"claim_scope,omitempty"`
+	ExpiresAt        null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *delegatedModeratorR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L delegatedModeratorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,6 +45,10 @@ var DelegatedModeratorColumns = struct {
 	Permissons       string
 	CreatedAt        string
 	UpdatedAt        string
+	Status           string
+	InviteExpiresAt  string
+	ClaimScope       string
+	ExpiresAt        string
 }{
 	ID:               "id",
 	ModChannelID:     "mod_channel_id",
@@ -47,6 +56,10 @@ var DelegatedModeratorColumns = struct {
 	Permissons:       "permissons",
 	CreatedAt:        "created_at",
 	UpdatedAt:        "updated_at",
+	Status:           "status",
+	InviteExpiresAt:  "invite_expires_at",
+	ClaimScope:       "claim_scope",
+	ExpiresAt:        "expires_at",
 }
 
 // Generated where
@@ -58,6 +71,10 @@ var DelegatedModeratorWhere = struct {
 	Permissons       whereHelperuint64
 	CreatedAt        whereHelpertime_Time
 	UpdatedAt        whereHelpertime_Time
+	Status           whereHelperstring
+	InviteExpiresAt  whereHelpernull_Time
+	ClaimScope       whereHelpernull_String
+	ExpiresAt        whereHelpernull_Time
 }{
 	ID:               whereHelperuint64{field: "`delegated_moderator`.`id`"},
 	ModChannelID:     whereHelperstring{field: "`delegated_moderator`.`mod_channel_id`"},
@@ -65,6 +82,10 @@ var DelegatedModeratorWhere = struct {
 	Permissons:       whereHelperuint64{field: "`delegated_moderator`.`permissons`"},
 	CreatedAt:        whereHelpertime_Time{field: "`delegated_moderator`.`created_at`"},
 	UpdatedAt:        whereHelpertime_Time{field: "`delegated_moderator`.`updated_at`"},
+	Status:           whereHelperstring{field: "`delegated_moderator`.`status`"},
+	InviteExpiresAt:  whereHelpernull_Time{field: "`delegated_moderator`.`invite_expires_at`"},
+	ClaimScope:       whereHelpernull_String{field: "`delegated_moderator`.`claim_scope`"},
+	ExpiresAt:        whereHelpernull_Time{field: "`delegated_moderator`.`expires_at`"},
 }
 
 // DelegatedModeratorRels is where relationship names are stored.
@@ -91,9 +112,9 @@ func (*delegatedModeratorR) NewStruct() *delegatedModeratorR {
 type delegatedModeratorL struct{}
 
 var (
-	delegatedModeratorAllColumns            = []string{"id", "mod_channel_id", "creator_channel_id", "permissons", "created_at", "updated_at"}
-	delegatedModeratorColumnsWithoutDefault = []string{"mod_channel_id", "creator_channel_id"}
-	delegatedModeratorColumnsWithDefault    = []string{"id", "permissons", "created_at", "updated_at"}
+	delegatedModeratorAllColumns            = []string{"id", "mod_channel_id", "creator_channel_id", "permissons", "created_at", "updated_at", "status", "invite_expires_at", "claim_scope", "expires_at"}
+	delegatedModeratorColumnsWithoutDefault = []string{"mod_channel_id", "creator_channel_id", "invite_expires_at", "claim_scope", "expires_at"}
+	delegatedModeratorColumnsWithDefault    = []string{"id", "permissons", "created_at", "updated_at", "status"}
 	delegatedModeratorPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
 	"github.com/volatiletech/sqlboiler/queries"
 	"github.com/volatiletech/sqlboiler/queries/qm"
@@ -22,10 +23,12 @@ import (
 
 // ReactionType is an object representing the database table.
 type ReactionType struct {
-	ID        uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID        uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name      string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	IsGlobal  bool        `boil:"is_global" json:"is_global" toml:"is_global" yaml:"is_global"`
+	CreatedBy null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
 
 	R *reactionTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L reactionTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -36,11 +39,15 @@ var ReactionTypeColumns = struct {
 	Name      string
 	CreatedAt string
 	UpdatedAt string
+	IsGlobal  string
+	CreatedBy string
 }{
 	ID:        "id",
 	Name:      "name",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	IsGlobal:  "is_global",
+	CreatedBy: "created_by",
 }
 
 // Generated where
@@ -50,23 +57,33 @@ var ReactionTypeWhere = struct {
 	Name      whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	IsGlobal  whereHelperbool
+	CreatedBy whereHelpernull_String
 }{
 	ID:        whereHelperuint64{field: "`reaction_type`.`id`"},
 	Name:      whereHelperstring{field: "`reaction_type`.`name`"},
 	CreatedAt: whereHelpertime_Time{field: "`reaction_type`.`created_at`"},
 	UpdatedAt: whereHelpertime_Time{field: "`reaction_type`.`updated_at`"},
+	IsGlobal:  whereHelperbool{field: "`reaction_type`.`is_global`"},
+	CreatedBy: whereHelpernull_String{field: "`reaction_type`.`created_by`"},
 }
 
 // ReactionTypeRels is where relationship names are stored.
 var ReactionTypeRels = struct {
-	Reactions string
+	CommentReactionCounts  string
+	CreatorChannelChannels string
+	Reactions              string
 }{
-	Reactions: "Reactions",
+	CommentReactionCounts:  "CommentReactionCounts",
+	CreatorChannelChannels: "CreatorChannelChannels",
+	Reactions:              "Reactions",
 }
 
 // reactionTypeR is where relationships are stored.
 type reactionTypeR struct {
-	Reactions ReactionSlice
+	CommentReactionCounts  CommentReactionCountSlice
+	CreatorChannelChannels ChannelSlice
+	Reactions              ReactionSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -78,9 +95,9 @@ func (*reactionTypeR) NewStruct() *reactionTypeR {
 type reactionTypeL struct{}
 
 var (
-	reactionTypeAllColumns            = []string{"id", "name", "created_at", "updated_at"}
-	reactionTypeColumnsWithoutDefault = []string{"name"}
-	reactionTypeColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	reactionTypeAllColumns            = []string{"id", "name", "created_at", "updated_at", "is_global", "created_by"}
+	reactionTypeColumnsWithoutDefault = []string{"name", "created_by"}
+	reactionTypeColumnsWithDefault    = []string{"id", "created_at", "updated_at", "is_global"}
 	reactionTypePrimaryKeyColumns     = []string{"id"}
 )
 
@@ -175,6 +192,49 @@ func (q reactionTypeQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// CommentReactionCounts retrieves all the comment_reaction_count's CommentReactionCounts with an executor.
+func (o *ReactionType) CommentReactionCounts(mods ...qm.QueryMod) commentReactionCountQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`comment_reaction_count`.`reaction_type_id`=?", o.ID),
+	)
+
+	query := CommentReactionCounts(queryMods...)
+	queries.SetFrom(query.Query, "`comment_reaction_count`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`comment_reaction_count`.*"})
+	}
+
+	return query
+}
+
+// CreatorChannelChannels retrieves all the channel's Channels with an executor via claim_id column.
+func (o *ReactionType) CreatorChannelChannels(mods ...qm.QueryMod) channelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("`creator_reaction_type` on `channel`.`claim_id` = `creator_reaction_type`.`creator_channel_id`"),
+		qm.Where("`creator_reaction_type`.`reaction_type_id`=?", o.ID),
+	)
+
+	query := Channels(queryMods...)
+	queries.SetFrom(query.Query, "`channel`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`channel`.*"})
+	}
+
+	return query
+}
+
 // Reactions retrieves all the reaction's Reactions with an executor.
 func (o *ReactionType) Reactions(mods ...qm.QueryMod) reactionQuery {
 	var queryMods []qm.QueryMod
@@ -196,6 +256,202 @@ func (o *ReactionType) Reactions(mods ...qm.QueryMod) reactionQuery {
 	return query
 }
 
+// LoadCommentReactionCounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (reactionTypeL) LoadCommentReactionCounts(e boil.Executor, singular bool, maybeReactionType interface{}, mods queries.Applicator) error {
+	var slice []*ReactionType
+	var object *ReactionType
+
+	if singular {
+		object = maybeReactionType.(*ReactionType)
+	} else {
+		slice = *maybeReactionType.(*[]*ReactionType)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reactionTypeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionTypeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`comment_reaction_count`), qm.WhereIn(`reaction_type_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comment_reaction_count")
+	}
+
+	var resultSlice []*CommentReactionCount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comment_reaction_count")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comment_reaction_count")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_reaction_count")
+	}
+
+	if singular {
+		object.R.CommentReactionCounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentReactionCountR{}
+			}
+			foreign.R.ReactionType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReactionTypeID {
+				local.R.CommentReactionCounts = append(local.R.CommentReactionCounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentReactionCountR{}
+				}
+				foreign.R.ReactionType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatorChannelChannels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (reactionTypeL) LoadCreatorChannelChannels(e boil.Executor, singular bool, maybeReactionType interface{}, mods queries.Applicator) error {
+	var slice []*ReactionType
+	var object *ReactionType
+
+	if singular {
+		object = maybeReactionType.(*ReactionType)
+	} else {
+		slice = *maybeReactionType.(*[]*ReactionType)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reactionTypeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reactionTypeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("`channel`.*, `a`.`reaction_type_id`"),
+		qm.From("`channel`"),
+		qm.InnerJoin("`creator_reaction_type` as `a` on `channel`.`claim_id` = `a`.`creator_channel_id`"),
+		qm.WhereIn("`a`.`reaction_type_id` in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load channel")
+	}
+
+	var resultSlice []*Channel
+
+	var localJoinCols []uint64
+	for results.Next() {
+		one := new(Channel)
+		var localJoinCol uint64
+
+		err = results.Scan(&one.ClaimID, &one.Name, &one.IsSpammer, &one.BlockedListInviteID, &one.BlockedListID, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for channel")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice channel")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on channel")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for channel")
+	}
+
+	if singular {
+		object.R.CreatorChannelChannels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &channelR{}
+			}
+			foreign.R.ReactionTypes = append(foreign.R.ReactionTypes, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.CreatorChannelChannels = append(local.R.CreatorChannelChannels, foreign)
+				if foreign.R == nil {
+					foreign.R = &channelR{}
+				}
+				foreign.R.ReactionTypes = append(foreign.R.ReactionTypes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadReactions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (reactionTypeL) LoadReactions(e boil.Executor, singular bool, maybeReactionType interface{}, mods queries.Applicator) error {
@@ -284,6 +540,199 @@ func (reactionTypeL) LoadReactions(e boil.Executor, singular bool, maybeReaction
 	return nil
 }
 
+// AddCommentReactionCounts adds the given related objects to the existing relationships
+// of the reaction_type, optionally inserting them as new records.
+// Appends related to o.R.CommentReactionCounts.
+// Sets related.R.ReactionType appropriately.
+func (o *ReactionType) AddCommentReactionCounts(exec boil.Executor, insert bool, related ...*CommentReactionCount) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReactionTypeID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment_reaction_count` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"reaction_type_id"}),
+				strmangle.WhereClause("`", "`", 0, commentReactionCountPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CommentID, rel.ReactionTypeID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReactionTypeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &reactionTypeR{
+			CommentReactionCounts: related,
+		}
+	} else {
+		o.R.CommentReactionCounts = append(o.R.CommentReactionCounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentReactionCountR{
+				ReactionType: o,
+			}
+		} else {
+			rel.R.ReactionType = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorChannelChannels adds the given related objects to the existing relationships
+// of the reaction_type, optionally inserting them as new records.
+// Appends related to o.R.CreatorChannelChannels.
+// Sets related.R.ReactionTypes appropriately.
+func (o *ReactionType) AddCreatorChannelChannels(exec boil.Executor, insert bool, related ...*Channel) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `creator_reaction_type` (`reaction_type_id`, `creator_channel_id`) values (?, ?)"
+		values := []interface{}{o.ID, rel.ClaimID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		_, err = exec.Exec(query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &reactionTypeR{
+			CreatorChannelChannels: related,
+		}
+	} else {
+		o.R.CreatorChannelChannels = append(o.R.CreatorChannelChannels, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &channelR{
+				ReactionTypes: ReactionTypeSlice{o},
+			}
+		} else {
+			rel.R.ReactionTypes = append(rel.R.ReactionTypes, o)
+		}
+	}
+	return nil
+}
+
+// SetCreatorChannelChannels removes all previously related items of the
+// reaction_type replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReactionTypes's CreatorChannelChannels accordingly.
+// Replaces o.R.CreatorChannelChannels with related.
+// Sets related.R.ReactionTypes's CreatorChannelChannels accordingly.
+func (o *ReactionType) SetCreatorChannelChannels(exec boil.Executor, insert bool, related ...*Channel) error {
+	query := "delete from `creator_reaction_type` where `reaction_type_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeCreatorChannelChannelsFromReactionTypesSlice(o, related)
+	if o.R != nil {
+		o.R.CreatorChannelChannels = nil
+	}
+	return o.AddCreatorChannelChannels(exec, insert, related...)
+}
+
+// RemoveCreatorChannelChannels relationships from objects passed in.
+// Removes related items from R.CreatorChannelChannels (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReactionTypes.
+func (o *ReactionType) RemoveCreatorChannelChannels(exec boil.Executor, related ...*Channel) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from `creator_reaction_type` where `reaction_type_id` = ? and `creator_channel_id` in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ClaimID)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err = exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeCreatorChannelChannelsFromReactionTypesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatorChannelChannels {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatorChannelChannels)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatorChannelChannels[i] = o.R.CreatorChannelChannels[ln-1]
+			}
+			o.R.CreatorChannelChannels = o.R.CreatorChannelChannels[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeCreatorChannelChannelsFromReactionTypesSlice(o *ReactionType, related []*Channel) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.ReactionTypes {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.ReactionTypes)
+			if ln > 1 && i < ln-1 {
+				rel.R.ReactionTypes[i] = rel.R.ReactionTypes[ln-1]
+			}
+			rel.R.ReactionTypes = rel.R.ReactionTypes[:ln-1]
+			break
+		}
+	}
+}
+
 // AddReactions adds the given related objects to the existing relationships
 // of the reaction_type, optionally inserting them as new records.
 // Appends related to o.R.Reactions.
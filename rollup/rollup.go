@@ -0,0 +1,91 @@
+package rollup
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+// Bucket returns the hourly bucket the time is rolled up into
+func Bucket(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Hour)
+}
+
+// Comment adds a new comment to the stats of the creator of the claim it was made on, in the transaction inserting it
+// so the stats only count committed comments. Flagged comments are only counted as flagged, they are not shown so they
+// are not counted in the comments, commenters or tips.
+func Comment(exec boil.Executor, comment *m.Comment) error {
+	if !comment.CreatorChannelID.Valid {
+		return nil
+	}
+	creatorID := comment.CreatorChannelID.String
+	bucket := Bucket(time.Unix(int64(comment.Timestamp), 0))
+	if comment.IsFlagged {
+		return run(exec, `INSERT INTO `+m.TableNames.CreatorStat+` (creator_channel_id, claim_id, bucket, flagged) VALUES (?, ?, ?, 1)
+			ON DUPLICATE KEY UPDATE flagged = flagged + 1`,
+			creatorID, comment.LbryClaimID, bucket)
+	}
+	err := run(exec, `INSERT INTO `+m.TableNames.CreatorStat+` (creator_channel_id, claim_id, bucket, comments) VALUES (?, ?, ?, 1)
+		ON DUPLICATE KEY UPDATE comments = comments + 1`,
+		creatorID, comment.LbryClaimID, bucket)
+	if err != nil {
+		return err
+	}
+	if comment.ChannelID.Valid {
+		err = run(exec, `INSERT IGNORE INTO `+m.TableNames.CreatorStatCommenter+` (creator_channel_id, claim_id, bucket, channel_id) VALUES (?, ?, ?, ?)`,
+			creatorID, comment.LbryClaimID, bucket, comment.ChannelID.String)
+		if err != nil {
+			return err
+		}
+	}
+	if !comment.Amount.IsZero() {
+		return run(exec, `INSERT INTO `+m.TableNames.CreatorStatTip+` (creator_channel_id, claim_id, bucket, currency, amount, count) VALUES (?, ?, ?, ?, ?, 1)
+			ON DUPLICATE KEY UPDATE amount = amount + VALUES(amount), count = count + 1`,
+			creatorID, comment.LbryClaimID, bucket, helper.TickerCurrency(comment), comment.Amount.Uint64)
+	}
+	return nil
+}
+
+// ReverseTip takes a refunded, disputed or reversed amount off the tips of the hyperchat in the stats of its creator,
+// and the hyperchat off the count once nothing of it is left.
+func ReverseTip(exec boil.Executor, comment *m.Comment, amount uint64, removed bool) error {
+	if !comment.CreatorChannelID.Valid {
+		return nil
+	}
+	count := 0
+	if removed {
+		count = 1
+	}
+	return run(exec, `UPDATE `+m.TableNames.CreatorStatTip+` SET amount = amount - LEAST(amount, ?), count = count - LEAST(count, ?)
+		WHERE creator_channel_id = ? AND claim_id = ? AND bucket = ? AND currency = ?`,
+		amount, count, comment.CreatorChannelID.String, comment.LbryClaimID, Bucket(time.Unix(int64(comment.Timestamp), 0)), helper.TickerCurrency(comment))
+}
+
+// Reaction adds or with a negative delta removes reactions of a type from the stats of the creator of the comment
+func Reaction(exec boil.Executor, comment *m.Comment, reactionType string, delta int) error {
+	if !comment.CreatorChannelID.Valid || delta == 0 {
+		return nil
+	}
+	return run(exec, `INSERT INTO `+m.TableNames.CreatorStatReaction+` (creator_channel_id, claim_id, bucket, reaction_type, count) VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE count = count + VALUES(count)`,
+		comment.CreatorChannelID.String, comment.LbryClaimID, Bucket(time.Now()), reactionType, delta)
+}
+
+// Block adds a block by the creator or one of their moderators to the creator's stats, under the claim the block was
+// made from if any.
+func Block(exec boil.Executor, creatorChannelID, claimID string) error {
+	return run(exec, `INSERT INTO `+m.TableNames.CreatorStat+` (creator_channel_id, claim_id, bucket, blocks) VALUES (?, ?, ?, 1)
+		ON DUPLICATE KEY UPDATE blocks = blocks + 1`,
+		creatorChannelID, claimID, Bucket(time.Now()))
+}
+
+func run(exec boil.Executor, query string, args ...interface{}) error {
+	_, err := queries.Raw(query, args...).Exec(exec)
+	return errors.Err(err)
+}
@@ -0,0 +1,168 @@
+package rollup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/db/dbtest"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+const (
+	creatorID = "9cb713f01bf247a0e03170b5ed00d5161340c486"
+	claimID   = "abe3c90453fd481383acb4e3d243e2f4efd43e02"
+)
+
+func testComment(channelID string, amount uint64, flagged bool) *m.Comment {
+	return &m.Comment{
+		LbryClaimID:      claimID,
+		ChannelID:        null.StringFrom(channelID),
+		CreatorChannelID: null.StringFrom(creatorID),
+		Timestamp:        int(time.Now().Unix()),
+		Amount:           null.NewUint64(amount, amount > 0),
+		IsFlagged:        flagged,
+	}
+}
+
+func initStats(t *testing.T) {
+	dbtest.Init(t, m.TableNames.CreatorStat, m.TableNames.CreatorStatCommenter, m.TableNames.CreatorStatTip, m.TableNames.CreatorStatReaction)
+}
+
+func TestComment(t *testing.T) {
+	initStats(t)
+	for _, c := range []*m.Comment{
+		testComment("599617e276c2704a3bff888991bd8a018df672a9", 0, false),
+		testComment("599617e276c2704a3bff888991bd8a018df672a9", 150000000, false),
+		testComment("0b66f8e5a3d1b9c1ab34f8b51f5c6f46c2a1b7d2", 0, true),
+		testComment("0b66f8e5a3d1b9c1ab34f8b51f5c6f46c2a1b7d2", 50000000, true),
+	} {
+		err := Comment(db.RW, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	stat, err := m.CreatorStats(m.CreatorStatWhere.CreatorChannelID.EQ(creatorID), m.CreatorStatWhere.ClaimID.EQ(claimID)).One(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Comments != 2 || stat.Flagged != 2 {
+		t.Errorf("expected 2 comments and 2 flagged, got %d comments and %d flagged", stat.Comments, stat.Flagged)
+	}
+	commenters, err := m.CreatorStatCommenters(m.CreatorStatCommenterWhere.CreatorChannelID.EQ(creatorID)).Count(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commenters != 1 {
+		t.Errorf("flagged comments should not count their commenter, got %d commenters", commenters)
+	}
+	tip, err := m.CreatorStatTips(m.CreatorStatTipWhere.CreatorChannelID.EQ(creatorID)).One(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tip.Amount != 150000000 || tip.Count != 1 {
+		t.Errorf("flagged hyperchats should not be counted, got %d in %d hyperchats", tip.Amount, tip.Count)
+	}
+}
+
+func TestRolledBack(t *testing.T) {
+	initStats(t)
+	rollback := errors.Base("rollback")
+	err := db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := Comment(tx, testComment("599617e276c2704a3bff888991bd8a018df672a9", 0, false))
+		if err != nil {
+			return err
+		}
+		err = Block(tx, creatorID, claimID)
+		if err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatal(err)
+	}
+	count, err := m.CreatorStats().Count(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("a rolled back transaction should not be counted, got %d stats", count)
+	}
+}
+
+func TestBlock(t *testing.T) {
+	initStats(t)
+	for _, claim := range []string{claimID, claimID, ""} {
+		err := Block(db.RW, creatorID, claim)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for claim, expected := range map[string]uint64{claimID: 2, "": 1} {
+		stat, err := m.CreatorStats(m.CreatorStatWhere.CreatorChannelID.EQ(creatorID), m.CreatorStatWhere.ClaimID.EQ(claim)).One(db.RO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stat.Blocks != expected {
+			t.Errorf("expected %d blocks for claim %q, got %d", expected, claim, stat.Blocks)
+		}
+	}
+}
+
+func TestReaction(t *testing.T) {
+	initStats(t)
+	comment := testComment("599617e276c2704a3bff888991bd8a018df672a9", 0, false)
+	for _, delta := range []int{1, 1, -1, 0} {
+		err := Reaction(db.RW, comment, "like", delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	reaction, err := m.CreatorStatReactions(m.CreatorStatReactionWhere.CreatorChannelID.EQ(creatorID)).One(db.RO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reaction.ReactionType != "like" || reaction.Count != 1 {
+		t.Errorf("expected 1 like, got %d %s", reaction.Count, reaction.ReactionType)
+	}
+}
+
+func TestReverseTip(t *testing.T) {
+	initStats(t)
+	tipped := testComment("599617e276c2704a3bff888991bd8a018df672a9", 150000000, false)
+	for _, c := range []*m.Comment{tipped, testComment("599617e276c2704a3bff888991bd8a018df672a9", 50000000, false)} {
+		err := Comment(db.RW, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		amount   uint64
+		removed  bool
+		expected uint64
+		count    uint64
+	}{
+		{100000000, false, 100000000, 2},
+		{50000000, true, 50000000, 1},
+		{200000000, true, 0, 0},
+	}
+	for _, test := range tests {
+		err := ReverseTip(db.RW, tipped, test.amount, test.removed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tip, err := m.CreatorStatTips(m.CreatorStatTipWhere.CreatorChannelID.EQ(creatorID)).One(db.RO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tip.Amount != test.expected || tip.Count != test.count {
+			t.Errorf("expected %d in %d hyperchats, got %d in %d", test.expected, test.count, tip.Amount, tip.Count)
+		}
+	}
+}
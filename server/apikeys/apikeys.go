@@ -0,0 +1,247 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/metrics"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/Avalanche-io/counter"
+	"github.com/karlseguin/ccache"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Scopes api keys can be given
+const (
+	// ScopeChanges gives access to the comment.Changes changefeed
+	ScopeChanges = "changes"
+	// ScopeModeration allows blocking, unblocking and purging on behalf of any channel without its signature
+	ScopeModeration = "moderation"
+	// ScopeStats allows reading the stats of any creator without their signature
+	ScopeStats = "stats"
+	// ScopeModerators allows managing the global moderators like a global moderator of commentapi.ModLevelAdmin
+	ScopeModerators = "moderators"
+)
+
+// Scopes are all the scopes api keys can be given
+var Scopes = []string{ScopeChanges, ScopeModeration, ScopeStats, ScopeModerators}
+
+// keyPrefix starts every api key so they are easy to recognize, ie in leaked secrets scans
+const keyPrefix = "cmt_"
+
+// rateWindow is the window the requests of each key are counted in against its rate limit
+const rateWindow = time.Minute
+
+// keys caches the api keys by the hash of the key, so a revoked key can keep working for up to keyCacheTime
+var keys = ccache.New(ccache.Configure().MaxSize(1000))
+
+const keyCacheTime = time.Minute
+
+var requestCounts = ccache.New(ccache.Configure().MaxSize(1000))
+
+// lastUsed throttles the updates of the last_used_at of keys to one per key every rateWindow
+var lastUsed = ccache.New(ccache.Configure().MaxSize(1000))
+
+type contextKey struct{}
+
+// Middleware authenticates the api key passed as a bearer token in the Authorization header. Unknown or revoked keys
+// and keys over their rate limit are rejected, requests without a bearer token go through unchanged.
+func Middleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == r.Header.Get("Authorization") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		key, err := authenticate(token)
+		if err != nil {
+			statusErr, ok := err.(api.StatusError)
+			if !ok {
+				logrus.Error(errors.FullTrace(err))
+				statusErr = api.StatusError{Err: err, Status: http.StatusInternalServerError}
+			}
+			http.Error(w, statusErr.Err.Error(), statusErr.Status)
+			return
+		}
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, key)))
+	})
+}
+
+func authenticate(token string) (*model.APIKey, error) {
+	key, err := findKey(hashKey(token))
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || key.RevokedAt.Valid {
+		return nil, api.StatusError{Err: errors.Err("invalid api key"), Status: http.StatusUnauthorized}
+	}
+	err = checkRate(key)
+	if err != nil {
+		metrics.APIKeyRequests.WithLabelValues(key.Name, "rate_limited").Inc()
+		return nil, err
+	}
+	metrics.APIKeyRequests.WithLabelValues(key.Name, "accepted").Inc()
+	touch(key)
+	return key, nil
+}
+
+// findKey returns the api key with the hash, or nil if there is none. Only existing keys are cached, so requests with
+// random tokens cannot evict them from the cache.
+func findKey(hash string) (*model.APIKey, error) {
+	if item := keys.Get(hash); item != nil && !item.Expired() {
+		return item.Value().(*model.APIKey), nil
+	}
+	key, err := model.APIKeys(model.APIKeyWhere.KeyHash.EQ(hash)).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	keys.Set(hash, key, keyCacheTime)
+	return key, nil
+}
+
+// checkRate counts the request against the rate limit of the key
+func checkRate(key *model.APIKey) error {
+	item, err := requestCounts.Fetch(key.KeyHash, rateWindow, func() (interface{}, error) {
+		return counter.New(), nil
+	})
+	if err != nil {
+		return errors.Err(err)
+	}
+	keyCounter, ok := item.Value().(*counter.Counter)
+	if !ok {
+		return errors.Err("could not convert counter from cache!")
+	}
+	if keyCounter.Get() >= int64(key.RateLimit) {
+		return api.StatusError{Err: errors.Err("the api key is over its rate limit of %d requests per minute", key.RateLimit), Status: http.StatusTooManyRequests}
+	}
+	keyCounter.Add(1)
+	return nil
+}
+
+// touch records that the key was used
+func touch(key *model.APIKey) {
+	if item := lastUsed.Get(key.KeyHash); item != nil && !item.Expired() {
+		return
+	}
+	lastUsed.Set(key.KeyHash, true, rateWindow)
+	go func() {
+		err := model.APIKeys(model.APIKeyWhere.ID.EQ(key.ID)).UpdateAll(db.RW, model.M{model.APIKeyColumns.LastUsedAt: time.Now()})
+		if err != nil {
+			logrus.Error(errors.FullTrace(err))
+		}
+	}()
+}
+
+// HasScope returns whether the request was made with an api key that has the scope
+func HasScope(r *http.Request, scope string) bool {
+	key, ok := r.Context().Value(contextKey{}).(*model.APIKey)
+	if !ok {
+		return false
+	}
+	for _, s := range strings.Split(key.Scopes, ",") {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyName returns the name of the api key the request was made with if it has the scope, so ValidateSignature let it
+// act as any channel, or an empty string otherwise
+func KeyName(r *http.Request, scope string) string {
+	if !HasScope(r, scope) {
+		return ""
+	}
+	return r.Context().Value(contextKey{}).(*model.APIKey).Name
+}
+
+// ValidateSignature validates the channel signed the digest of the call, unless the request was made with an api key
+// that has the scope, which lets trusted applications act as any channel.
+func ValidateSignature(r *http.Request, scope, channelClaimID, signature, signingTS string, digest lbry.Digest) error {
+	if HasScope(r, scope) {
+		return nil
+	}
+	return lbry.ValidateSignature(channelClaimID, signature, signingTS, digest)
+}
+
+// Create creates an api key with the scopes, returning the key which is only stored hashed
+func Create(name string, scopes []string, rateLimit int) (string, error) {
+	for _, scope := range scopes {
+		if !isScope(scope) {
+			return "", errors.Err("'%s' is not a scope, it must be one of %s", scope, strings.Join(Scopes, ", "))
+		}
+	}
+	if len(scopes) == 0 {
+		return "", errors.Err("an api key needs at least one scope")
+	}
+	if rateLimit <= 0 {
+		return "", errors.Err("the rate limit must be positive")
+	}
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	token := keyPrefix + hex.EncodeToString(secret)
+	key := &model.APIKey{
+		Name:      name,
+		KeyHash:   hashKey(token),
+		Scopes:    strings.Join(scopes, ","),
+		RateLimit: rateLimit,
+	}
+	err = key.Insert(db.RW, boil.Infer())
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	return token, nil
+}
+
+// Revoke revokes the api key with the name
+func Revoke(name string) error {
+	key, err := model.APIKeys(model.APIKeyWhere.Name.EQ(name)).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Err("there is no api key named %s", name)
+	}
+	if err != nil {
+		return errors.Err(err)
+	}
+	if key.RevokedAt.Valid {
+		return nil
+	}
+	key.RevokedAt.SetValid(time.Now())
+	err = key.Update(db.RW, boil.Whitelist(model.APIKeyColumns.RevokedAt))
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+func isScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func hashKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,54 @@
+package apikeys
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lbryio/commentron/model"
+)
+
+func TestHasScope(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v2", nil)
+	if HasScope(r, ScopeChanges) {
+		t.Error("a request without an api key should have no scope")
+	}
+	if KeyName(r, ScopeChanges) != "" {
+		t.Error("a request without an api key should have no key name")
+	}
+	key := &model.APIKey{Name: "indexer", Scopes: ScopeChanges + "," + ScopeStats}
+	r = r.WithContext(context.WithValue(r.Context(), contextKey{}, key))
+	for scope, expected := range map[string]bool{ScopeChanges: true, ScopeStats: true, ScopeModeration: false, "": false} {
+		if HasScope(r, scope) != expected {
+			t.Errorf("HasScope(%q) should be %v", scope, expected)
+		}
+		if (KeyName(r, scope) == key.Name) != expected {
+			t.Errorf("KeyName(%q) should only be the key name if the key has the scope", scope)
+		}
+	}
+}
+
+func TestCheckRate(t *testing.T) {
+	key := &model.APIKey{KeyHash: hashKey("cmt_test"), RateLimit: 3}
+	for i := 0; i < key.RateLimit; i++ {
+		err := checkRate(key)
+		if err != nil {
+			t.Fatalf("request %d should be within the rate limit: %v", i+1, err)
+		}
+	}
+	if checkRate(key) == nil {
+		t.Error("requests over the rate limit should be rejected")
+	}
+}
+
+func TestMiddlewareWithoutKey(t *testing.T) {
+	called := false
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true }))
+	r := httptest.NewRequest(http.MethodPost, "/api/v2", nil)
+	r.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if !called {
+		t.Error("requests without a bearer token should go through")
+	}
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/payments"
+	"github.com/lbryio/commentron/server/webhooks"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RunSharedJobs turns on the jobs that only one commentron instance should run, it is on unless turned off for the
+// extra instances
+var RunSharedJobs = true
+
+// Start launches the background jobs of the commentron instance
+func Start() {
+	schedule("ticker_expiry", 5*time.Second, tickerExpiry())
+	// notifications are leased before they are delivered, so every instance drains the outbox
+	schedule("notification_outbox", 5*time.Second, lbry.DeliverNotifications)
+	schedule("signature_uses", 10*time.Minute, lbry.ForgetSignatures)
+	if RunSharedJobs {
+		schedule("tip_verification", time.Minute, payments.VerifyTips)
+		schedule("hot_scores", 10*time.Minute, hotScores)
+		schedule("webhooks", 5*time.Second, webhooks.Deliver)
+	} else {
+		logrus.Info("shared jobs are turned off, another instance must run them")
+	}
+}
+
+func schedule(name string, interval time.Duration, job func() error) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			err := job()
+			if err != nil {
+				logrus.Errorf("job %s failed: %s", name, errors.FullTrace(err))
+			}
+		}
+	}()
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// hotScoreWindow is how long the hot scores of comments keep being recomputed, older comments have decayed enough
+// that their last score does not matter
+const hotScoreWindow = 7 * 24 * time.Hour
+
+const hotScoreBatchSize = 500
+
+// hotScores recomputes the decaying hot scores of the recent comments
+func hotScores() error {
+	now := time.Now()
+	var scoring []interface{}
+	for _, name := range config.ScoringReactions() {
+		scoring = append(scoring, name)
+	}
+	var reactionTypes m.ReactionTypeSlice
+	if len(scoring) > 0 {
+		var err error
+		reactionTypes, err = m.ReactionTypes(qm.WhereIn(m.ReactionTypeColumns.Name+" IN ?", scoring...)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	typeNames := make(map[uint64]string)
+	var typeIDs []interface{}
+	for _, rt := range reactionTypes {
+		typeNames[rt.ID] = rt.Name
+		typeIDs = append(typeIDs, rt.ID)
+	}
+
+	lastTimestamp, lastID := int(now.Add(-hotScoreWindow).Unix()), ""
+	for {
+		comments, err := m.Comments(
+			qm.Select(m.CommentColumns.CommentID, m.CommentColumns.Timestamp),
+			qm.Where("("+m.CommentColumns.Timestamp+" > ? OR ("+m.CommentColumns.Timestamp+" = ? AND "+m.CommentColumns.CommentID+" > ?))", lastTimestamp, lastTimestamp, lastID),
+			qm.OrderBy(m.CommentColumns.Timestamp+", "+m.CommentColumns.CommentID),
+			qm.Limit(hotScoreBatchSize)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if len(comments) == 0 {
+			return nil
+		}
+		err = updateHotScores(comments, typeNames, typeIDs, now)
+		if err != nil {
+			return err
+		}
+		last := comments[len(comments)-1]
+		lastTimestamp, lastID = last.Timestamp, last.CommentID
+	}
+}
+
+func updateHotScores(comments m.CommentSlice, typeNames map[uint64]string, typeIDs []interface{}, now time.Time) error {
+	commentIDs := make([]interface{}, len(comments))
+	for i, c := range comments {
+		commentIDs[i] = c.CommentID
+	}
+	likes := make(map[string]int)
+	dislikes := make(map[string]int)
+	if len(typeIDs) > 0 {
+		counts, err := m.CommentReactionCounts(
+			qm.WhereIn(m.CommentReactionCountColumns.CommentID+" IN ?", commentIDs...),
+			qm.WhereIn(m.CommentReactionCountColumns.ReactionTypeID+" IN ?", typeIDs...)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, c := range counts {
+			if config.ReactionScore(typeNames[c.ReactionTypeID]) > 0 {
+				likes[c.CommentID] += c.Count
+			} else {
+				dislikes[c.CommentID] += c.Count
+			}
+		}
+	}
+
+	cases := make([]string, len(comments))
+	args := make([]interface{}, 0, len(comments)*3)
+	for i, c := range comments {
+		cases[i] = "WHEN ? THEN ?"
+		args = append(args, c.CommentID, helper.HotScore(likes[c.CommentID], dislikes[c.CommentID], time.Unix(int64(c.Timestamp), 0), now))
+	}
+	args = append(args, commentIDs...)
+	query := `UPDATE ` + m.TableNames.Comment + ` SET ` + m.CommentColumns.HotScore + ` = CASE ` + m.CommentColumns.CommentID + ` ` + strings.Join(cases, " ") + ` END
+		WHERE ` + m.CommentColumns.CommentID + ` IN (?` + strings.Repeat(",?", len(comments)-1) + `)`
+	_, err := queries.Raw(query, args...).Exec(db.RW)
+	return errors.Err(err)
+}
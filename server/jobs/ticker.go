@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/websocket"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+)
+
+// tickerExpiry returns the job pushing an expire event for every hyperchat that fell off the ticker since its last run.
+// Each instance only pushes to its own websocket subscribers so the event is not sent through sockety.
+func tickerExpiry() func() error {
+	lastCheck := uint64(time.Now().Unix())
+	return func() error {
+		now := uint64(time.Now().Unix())
+		expired, err := m.Comments(
+			m.CommentWhere.PinnedUntil.GT(null.Uint64From(lastCheck)),
+			m.CommentWhere.PinnedUntil.LTE(null.Uint64From(now)),
+			m.CommentWhere.IsFlagged.EQ(false)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		lastCheck = now
+		for _, c := range expired {
+			websocket.PushTo(&websocket.PushNotification{
+				Type: "ticker_expire",
+				Data: map[string]interface{}{"comment_id": c.CommentID, "claim_id": c.LbryClaimID},
+			}, c.LbryClaimID)
+		}
+		return nil
+	}
+}
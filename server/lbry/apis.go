@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
@@ -17,6 +18,9 @@ import (
 var apiToken string
 var apiURL string
 
+// notifyTimeout keeps a hanging internal-apis from holding up the delivery of the rest of the outbox
+const notifyTimeout = 30 * time.Second
+
 type apiClient struct{}
 
 // CommentResponse is the response structure from internal-apis for the comment event api
@@ -26,21 +30,19 @@ type CommentResponse struct {
 	Data    string      `json:"data"`
 }
 
-// Notify notifies internal-apis of a new comment when one is recieved.
-func (c apiClient) Notify(options NotifyOptions) {
+// Notify notifies internal-apis of a comment being created, edited or abandoned. It is called by the notification
+// outbox, which retries it when it fails.
+func (c apiClient) Notify(options NotifyOptions) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			logrus.Error("recovered from failed notification to internal-apis: ", r)
+			err = errors.Err("recovered from failed notification to internal-apis: %v", r)
 		}
 	}()
-	err := notify(options)
-	if err != nil {
-		logrus.Error("API Notification: ", errors.FullTrace(err))
-	}
+	return notify(options)
 }
 
 func notify(options NotifyOptions) error {
-	c := http.Client{}
+	c := http.Client{Timeout: notifyTimeout}
 	form := make(url.Values)
 	form.Set("auth_token", apiToken)
 	form.Set("action_type", options.ActionType)
@@ -65,6 +67,10 @@ func notify(options NotifyOptions) error {
 		form.Set("currency", *options.Currency)
 	}
 
+	if options.PaymentStatus != nil {
+		form.Set("payment_status", *options.PaymentStatus)
+	}
+
 	response, err := c.PostForm(apiURL, form)
 	if err != nil {
 		return errors.Err(err)
@@ -77,17 +83,16 @@ func notify(options NotifyOptions) error {
 	if err != nil {
 		return errors.Err(err)
 	}
+	if response.StatusCode >= 300 {
+		return errors.Err("notification failure[status - %d]: %s", response.StatusCode, string(b))
+	}
+	if response.StatusCode > 200 {
+		logrus.Warning("Notification Failure[Status - ", response.StatusCode, "] : ")
+	}
 	var me CommentResponse
 	err = json.Unmarshal(b, &me)
 	if err != nil {
 		return errors.Err(err)
 	}
-	if response.StatusCode > 200 {
-		if response.StatusCode <= 300 {
-			logrus.Warning("Notification Failure[Status - ", response.StatusCode, "] : ")
-		} else {
-			logrus.Error("Notification Failure[Status - ", response.StatusCode, "] : ")
-		}
-	}
 	return nil
 }
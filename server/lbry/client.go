@@ -1,6 +1,9 @@
 package lbry
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/lbryio/commentron/env"
 	"github.com/lbryio/lbry.go/v2/extras/jsonrpc"
 	"github.com/sirupsen/logrus"
@@ -17,30 +20,44 @@ type SDKClient interface {
 	GetTx(string) (*jsonrpc.TransactionSummary, error)
 	GetClaim(string) (*jsonrpc.Claim, error)
 	GetSigningChannelForClaim(string) (*jsonrpc.Claim, error)
+	GetSupportStatus(txid string, nout uint64) (*SupportStatus, error)
+}
+
+// SupportStatus is the state on chain of a support output
+type SupportStatus struct {
+	// Found is false when the transaction or the output does not exist
+	Found         bool
+	Confirmations int
+	IsSpent       bool
+	// Amount of the support in dewies
+	Amount uint64
 }
 
 // NotifyOptions Are the options used to construct the comment event api signature.
 type NotifyOptions struct {
-	ActionType string
-	CommentID  string
-	ChannelID  *string
-	ParentID   *string
-	Comment    *string
-	ClaimID    string
-	Amount     uint64
-	IsFiat     bool
-	Currency   *string
+	ActionType string  `json:"action_type"`
+	CommentID  string  `json:"comment_id"`
+	ChannelID  *string `json:"channel_id,omitempty"`
+	ParentID   *string `json:"parent_id,omitempty"`
+	Comment    *string `json:"comment,omitempty"`
+	ClaimID    string  `json:"claim_id"`
+	Amount     uint64  `json:"amount,omitempty"`
+	IsFiat     bool    `json:"is_fiat,omitempty"`
+	Currency   *string `json:"currency,omitempty"`
+	// Set when the payment of a hyperchat changes after it was created, ie a refund
+	PaymentStatus *string `json:"payment_status,omitempty"`
 }
 
 // APIClient is the interface type for internal-api calls
 type APIClient interface {
-	Notify(NotifyOptions)
+	Notify(NotifyOptions) error
 }
 
 // Init initializes the configuration of the LBRY clients and allows for mock clients for testing
 func Init(conf *env.Config) {
 	SDK = &mockSDK{}
 	API = &mockAPI{}
+	usedSignatures = dbSignatureUses{}
 	if conf.SDKUrl != "" {
 		SDK = &sdkClient{}
 		sdkURL = conf.SDKUrl
@@ -61,20 +78,86 @@ func Init(conf *env.Config) {
 
 type mockSDK struct{}
 
+var mockSupports = make(map[string]*SupportStatus)
+var mockSupportsMu sync.RWMutex
+
+// SetMockSupportStatus sets the status the mock sdk returns for a support, used to simulate tips being confirmed,
+// abandoned or dropped. Passing nil removes it.
+func SetMockSupportStatus(txid string, nout uint64, status *SupportStatus) {
+	mockSupportsMu.Lock()
+	defer mockSupportsMu.Unlock()
+	key := fmt.Sprintf("%s:%d", txid, nout)
+	if status == nil {
+		delete(mockSupports, key)
+		return
+	}
+	mockSupports[key] = status
+}
+
+var mockSigningChannels = make(map[string]*jsonrpc.Claim)
+var mockSigningChannelsMu sync.RWMutex
+
+// SetMockSigningChannel sets the channel the mock sdk returns as the signer of a claim, used to simulate the claims of
+// a creator. Passing nil removes it.
+func SetMockSigningChannel(claimID string, channel *jsonrpc.Claim) {
+	mockSigningChannelsMu.Lock()
+	defer mockSigningChannelsMu.Unlock()
+	if channel == nil {
+		delete(mockSigningChannels, claimID)
+		return
+	}
+	mockSigningChannels[claimID] = channel
+}
+
 func (m *mockSDK) GetClaim(claimID string) (*jsonrpc.Claim, error) {
 	return nil, nil
 }
 
 func (m *mockSDK) GetSigningChannelForClaim(channelClaimID string) (*jsonrpc.Claim, error) {
-	return nil, nil
+	mockSigningChannelsMu.RLock()
+	defer mockSigningChannelsMu.RUnlock()
+	return mockSigningChannels[channelClaimID], nil
 }
 
 func (m *mockSDK) GetTx(txid string) (*jsonrpc.TransactionSummary, error) {
 	return nil, nil
 }
 
+func (m *mockSDK) GetSupportStatus(txid string, nout uint64) (*SupportStatus, error) {
+	mockSupportsMu.RLock()
+	defer mockSupportsMu.RUnlock()
+	return mockSupports[fmt.Sprintf("%s:%d", txid, nout)], nil
+}
+
 type mockAPI struct{}
 
-func (m *mockAPI) Notify(options NotifyOptions) {
+var mockNotifications []NotifyOptions
+var mockNotifyErr error
+var mockNotificationsMu sync.Mutex
 
+// SetMockNotifyError sets the error the mock internal-apis fails notifications with, used to simulate it being down.
+// Passing nil lets the notifications through again.
+func SetMockNotifyError(err error) {
+	mockNotificationsMu.Lock()
+	defer mockNotificationsMu.Unlock()
+	mockNotifyErr = err
+}
+
+// MockNotifications returns the notifications the mock internal-apis received since it was last called, oldest first
+func MockNotifications() []NotifyOptions {
+	mockNotificationsMu.Lock()
+	defer mockNotificationsMu.Unlock()
+	notifications := mockNotifications
+	mockNotifications = nil
+	return notifications
+}
+
+func (m *mockAPI) Notify(options NotifyOptions) error {
+	mockNotificationsMu.Lock()
+	defer mockNotificationsMu.Unlock()
+	if mockNotifyErr != nil {
+		return mockNotifyErr
+	}
+	mockNotifications = append(mockNotifications, options)
+	return nil
 }
@@ -0,0 +1,67 @@
+package lbry
+
+import (
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+// creatorBackfillBatch is how many claims are resolved per batch when backfilling the creators of comments
+const creatorBackfillBatch = 100
+
+// BackfillCreators sets the creator channel of the comments made before it was stored with each comment, by resolving
+// the channel that signed their claim, and records the likes of the creator on them. Comments on claims without a
+// signing channel are left without a creator.
+func BackfillCreators() error {
+	var lastClaimID string
+	var resolved, updated int64
+	for {
+		var claims []struct {
+			ClaimID string `boil:"lbry_claim_id"`
+		}
+		err := queries.Raw(`SELECT DISTINCT lbry_claim_id FROM comment WHERE creator_channel_id IS NULL AND lbry_claim_id > ?
+			ORDER BY lbry_claim_id LIMIT ?`, lastClaimID, creatorBackfillBatch).Bind(nil, db.RO, &claims)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if len(claims) == 0 {
+			break
+		}
+		for _, claim := range claims {
+			lastClaimID = claim.ClaimID
+			channel, err := SDK.GetSigningChannelForClaim(claim.ClaimID)
+			if err != nil {
+				logrus.Warningf("Backfill Creators: could not resolve claim %s: %s", claim.ClaimID, err.Error())
+				continue
+			}
+			if channel == nil {
+				continue
+			}
+			result, err := queries.Raw(`UPDATE comment SET creator_channel_id = ? WHERE lbry_claim_id = ? AND creator_channel_id IS NULL`,
+				channel.ClaimID, claim.ClaimID).Exec(db.RW)
+			if err != nil {
+				return errors.Err(err)
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return errors.Err(err)
+			}
+			_, err = queries.Raw(`UPDATE comment c
+				INNER JOIN reaction r ON r.comment_id = c.comment_id AND r.channel_id = c.creator_channel_id
+				INNER JOIN reaction_type rt ON rt.id = r.reaction_type_id AND rt.name = ?
+				SET c.creator_liked_by = r.channel_id
+				WHERE c.lbry_claim_id = ? AND c.creator_liked_by IS NULL`, helper.LikeReaction, claim.ClaimID).Exec(db.RW)
+			if err != nil {
+				return errors.Err(err)
+			}
+			resolved++
+			updated += rows
+		}
+		logrus.Infof("Backfill Creators: %d claims resolved, %d comments updated", resolved, updated)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package lbry
+
+import (
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+// ValidateDelegation validates a call made for a creator channel. Without a mod channel the creator signs the call,
+// otherwise a delegated moderator of the creator with all the commentapi.Permission* bits of permissions does. It
+// returns the channel that signed the call and the creator channel it acts for. The calls are creator wide, so
+// delegates scoped to some claims of the creator cannot make them.
+func ValidateDelegation(creatorChannelID, creatorChannelName, modChannelID, modChannelName string, permissions uint64, signature, signingTS string, digest Digest) (*model.Channel, *model.Channel, error) {
+	if modChannelID == "" {
+		creatorChannel, err := helper.FindOrCreateChannel(creatorChannelID, creatorChannelName)
+		if err != nil {
+			return nil, nil, errors.Err(err)
+		}
+		err = ValidateSignature(creatorChannel.ClaimID, signature, signingTS, digest)
+		if err != nil {
+			return nil, nil, err
+		}
+		return creatorChannel, creatorChannel, nil
+	}
+	modChannel, creatorChannel, err := helper.GetModerator(modChannelID, modChannelName, creatorChannelID, creatorChannelName, "", permissions)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = ValidateSignature(modChannel.ClaimID, signature, signingTS, digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return modChannel, creatorChannel, nil
+}
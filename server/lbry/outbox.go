@@ -0,0 +1,138 @@
+package lbry
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/metrics"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+const (
+	// maxNotificationAttempts is how many times a notification is tried before it is dead lettered
+	maxNotificationAttempts = 10
+	// notificationBatch is how many notifications are delivered each time the outbox is processed
+	notificationBatch = 200
+	firstRetryDelay   = 30 * time.Second
+	maxRetryDelay     = time.Hour
+	// notificationLease is how long an instance has to deliver a notification it claimed before others can retry it
+	notificationLease = 5 * time.Minute
+)
+
+// QueueNotification adds the notification for internal-apis to the outbox. It should be passed the transaction of
+// the change it is about so it is only delivered if the change is committed.
+func QueueNotification(exec boil.Executor, options NotifyOptions) error {
+	payload, err := json.Marshal(options)
+	if err != nil {
+		return errors.Err(err)
+	}
+	notification := &model.NotificationOutbox{Payload: string(payload), NextAttemptAt: time.Now()}
+	err = notification.Insert(exec, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+// DeliverNotifications sends the notifications of the outbox that are due to internal-apis, oldest first. Failed notifications are retried with an exponential backoff and dead lettered when they keep failing.
+func DeliverNotifications() error {
+	due, err := model.NotificationOutboxes(
+		model.NotificationOutboxWhere.DeadLetteredAt.IsNull(),
+		model.NotificationOutboxWhere.NextAttemptAt.LTE(time.Now()),
+		qm.OrderBy(model.NotificationOutboxColumns.ID),
+		qm.Limit(notificationBatch)).All(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, notification := range due {
+		err := deliver(notification)
+		if err != nil {
+			return err
+		}
+	}
+	pending, err := model.NotificationOutboxes(model.NotificationOutboxWhere.DeadLetteredAt.IsNull()).Count(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	metrics.NotificationsPending.Set(float64(pending))
+	return nil
+}
+
+// deliver claims the notification and sends it, removing it from the outbox when it succeeds and scheduling its next
+// attempt when it fails. Notifications claimed by another instance are skipped.
+func deliver(notification *model.NotificationOutbox) error {
+	claimed, err := claimNotification(notification)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+	if attemptNotification(notification) {
+		err = notification.Delete(db.RW)
+		if err != nil {
+			return errors.Err(err)
+		}
+		return nil
+	}
+	err = notification.Update(db.RW, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+// claimNotification leases the due notification to this instance so the other instances running the shared jobs do
+// not deliver it too. The lease running out lets another instance retry it if this one stops before it is done.
+func claimNotification(notification *model.NotificationOutbox) (bool, error) {
+	now := time.Now()
+	result, err := queries.Raw(`UPDATE `+model.TableNames.NotificationOutbox+` SET `+model.NotificationOutboxColumns.NextAttemptAt+` = ?
+		WHERE `+model.NotificationOutboxColumns.ID+` = ? AND `+model.NotificationOutboxColumns.NextAttemptAt+` <= ? AND `+model.NotificationOutboxColumns.DeadLetteredAt+` IS NULL`,
+		now.Add(notificationLease), notification.ID, now).Exec(db.RW)
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return rows == 1, nil
+}
+
+// attemptNotification sends the notification and returns whether it was delivered. When it was not, the failure and
+// when to retry it are recorded on the notification, or it is dead lettered when it keeps failing.
+func attemptNotification(notification *model.NotificationOutbox) bool {
+	var options NotifyOptions
+	err := json.Unmarshal([]byte(notification.Payload), &options)
+	if err != nil {
+		// retrying cannot fix the payload
+		notification.Attempts = maxNotificationAttempts - 1
+	} else {
+		err = API.Notify(options)
+	}
+	if err == nil {
+		metrics.Notifications.WithLabelValues("delivered").Inc()
+		return true
+	}
+
+	notification.Attempts++
+	notification.LastError.SetValid(err.Error())
+	if notification.Attempts >= maxNotificationAttempts {
+		metrics.Notifications.WithLabelValues("dead_lettered").Inc()
+		logrus.Errorf("API Notification %d dead lettered after %d attempts: %s", notification.ID, notification.Attempts, errors.FullTrace(err))
+		notification.DeadLetteredAt.SetValid(time.Now())
+	} else {
+		metrics.Notifications.WithLabelValues("failed").Inc()
+		notification.NextAttemptAt = time.Now().Add(helper.Backoff(notification.Attempts, firstRetryDelay, maxRetryDelay))
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package lbry
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+func TestAttemptNotification(t *testing.T) {
+	API = &mockAPI{}
+	defer SetMockNotifyError(nil)
+
+	options := NotifyOptions{ActionType: "C", CommentID: "comment", ClaimID: "claim"}
+	payload, err := json.Marshal(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notification := &model.NotificationOutbox{Payload: string(payload), NextAttemptAt: time.Now()}
+	if !attemptNotification(notification) {
+		t.Fatal("expected the notification to be delivered")
+	}
+	delivered := MockNotifications()
+	if len(delivered) != 1 || delivered[0].CommentID != options.CommentID || delivered[0].ActionType != options.ActionType {
+		t.Errorf("expected %v to be delivered, got %v", options, delivered)
+	}
+
+	SetMockNotifyError(errors.Err("internal-apis is down"))
+	if attemptNotification(notification) {
+		t.Fatal("expected the notification to fail")
+	}
+	if notification.Attempts != 1 || notification.LastError.String != "internal-apis is down" {
+		t.Errorf("expected 1 failed attempt, got %d: %s", notification.Attempts, notification.LastError.String)
+	}
+	if !notification.NextAttemptAt.After(time.Now()) || notification.DeadLetteredAt.Valid {
+		t.Error("expected the notification to be retried later")
+	}
+	for notification.Attempts < maxNotificationAttempts {
+		attemptNotification(notification)
+	}
+	if !notification.DeadLetteredAt.Valid {
+		t.Errorf("expected the notification to be dead lettered after %d attempts", maxNotificationAttempts)
+	}
+	if len(MockNotifications()) != 0 {
+		t.Error("failed notifications should not be recorded as delivered")
+	}
+
+	SetMockNotifyError(nil)
+	broken := &model.NotificationOutbox{Payload: "{", NextAttemptAt: time.Now()}
+	if attemptNotification(broken) || !broken.DeadLetteredAt.Valid {
+		t.Error("expected a notification that cannot be read to be dead lettered right away")
+	}
+	if len(MockNotifications()) != 0 {
+		t.Error("a notification that cannot be read should not be sent")
+	}
+}
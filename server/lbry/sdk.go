@@ -1,6 +1,7 @@
 package lbry
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/lbryio/commentron/metrics"
@@ -8,6 +9,7 @@ import (
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 	"github.com/lbryio/lbry.go/v2/extras/jsonrpc"
 
+	"github.com/btcsuite/btcutil"
 	"github.com/karlseguin/ccache"
 )
 
@@ -76,3 +78,30 @@ func (sdk *sdkClient) GetTx(txid string) (*jsonrpc.TransactionSummary, error) {
 	}
 	return summary, nil
 }
+
+// GetSupportStatus retrieves the confirmations and spent state of a support output
+func (sdk *sdkClient) GetSupportStatus(txid string, nout uint64) (*SupportStatus, error) {
+	defer metrics.SDKCall(time.Now(), "transaction-show")
+	summary, err := sdk.GetTx(txid)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if summary == nil || len(summary.Outputs) <= int(nout) {
+		return &SupportStatus{Found: false}, nil
+	}
+	output := summary.Outputs[int(nout)]
+	lbc, err := strconv.ParseFloat(output.Amount, 64)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	amount, err := btcutil.NewAmount(lbc)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	return &SupportStatus{
+		Found:         true,
+		Confirmations: output.Confirmations,
+		IsSpent:       output.IsSpent,
+		Amount:        uint64(amount),
+	}, nil
+}
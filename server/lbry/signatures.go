@@ -0,0 +1,55 @@
+package lbry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// dbSignatureUses shares the used signatures between the instances through the database, the unique key on the
+// channel and signature letting only one of them record a signature
+type dbSignatureUses struct{}
+
+func (dbSignatureUses) use(channelClaimID, signatureR, method string, expiry time.Duration) error {
+	now := time.Now()
+	used := &model.SignatureUse{ChannelID: channelClaimID, SignatureR: signatureR, Method: method, ExpiresAt: now.Add(expiry)}
+	err := used.Insert(db.RW, boil.Infer())
+	if err == nil {
+		return nil
+	}
+	if !strings.Contains(err.Error(), "Duplicate entry") {
+		return errors.Err(err)
+	}
+	previous, err := model.SignatureUses(
+		model.SignatureUseWhere.ChannelID.EQ(channelClaimID),
+		model.SignatureUseWhere.SignatureR.EQ(signatureR)).One(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if previous.ExpiresAt.After(now) {
+		return replayed()
+	}
+	previous.Method = method
+	previous.ExpiresAt = now.Add(expiry)
+	err = previous.Update(db.RW, boil.Whitelist(model.SignatureUseColumns.Method, model.SignatureUseColumns.ExpiresAt))
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+// ForgetSignatures removes the used signatures that expired, they can no longer be replayed as they are outside of
+// the signing window
+func ForgetSignatures() error {
+	err := model.SignatureUses(model.SignatureUseWhere.ExpiresAt.LT(time.Now())).DeleteAll(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package lbry
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/metrics"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/karlseguin/ccache"
+)
+
+// Digest is what a channel signs to call an rpc method
+type Digest struct {
+	// Method is the rpc method called, ie comment.Create
+	Method string
+	// Fields are the arguments of the call covered by the signature, in the order they are signed
+	Fields []string
+	// Legacy is the data clients signed before method digests, accepted while config.LegacySignatures is set. It is
+	// empty for the methods added after method digests, which can only be signed over their digest.
+	Legacy string
+	// Reusable is set for read only calls, whose signature can be used more than once within the signing window
+	Reusable bool
+}
+
+// Call is the digest of a call to method that changes something, so its signature can only be used once. Clients that
+// predate method digests signed legacy instead.
+func Call(method, legacy string, fields ...string) Digest {
+	return Digest{Method: method, Fields: fields, Legacy: legacy}
+}
+
+// ReadOnly is the digest of a read only call to method, clients can sign it once and reuse it within the signing window.
+func ReadOnly(method, legacy string, fields ...string) Digest {
+	return Digest{Method: method, Fields: fields, Legacy: legacy, Reusable: true}
+}
+
+// callerArgs are the arguments identifying who makes a call, which are signed separately from the canonical arguments
+var callerArgs = []string{"channel_id", "channel_name", "mod_channel_id", "mod_channel_name", "signature", "signing_ts"}
+
+// CanonicalArgs is the digest field covering all the arguments passed to a call that takes many optional ones. It is
+// the JSON object of the arguments that are not null, leaving out the channels making the call and the signature, with
+// the keys of all objects sorted, no whitespace and no html escaping.
+func CanonicalArgs(args interface{}) (string, error) {
+	b, err := json.Marshal(args)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	err = decoder.Decode(&fields)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	for _, name := range callerArgs {
+		delete(fields, name)
+	}
+	for name, value := range fields {
+		if value == nil {
+			delete(fields, name)
+		}
+	}
+	var canonical bytes.Buffer
+	encoder := json.NewEncoder(&canonical)
+	encoder.SetEscapeHTML(false)
+	// maps are encoded with sorted keys
+	err = encoder.Encode(fields)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	return strings.TrimSuffix(canonical.String(), "\n"), nil
+}
+
+// validateDigest validates the signature is fresh, signed over the method digest or legacy data, and not replayed
+func validateDigest(channelClaimID, signature, signingTS string, digest Digest, pubkey []byte) error {
+	err := checkSigningTS(signingTS, time.Now())
+	if err != nil {
+		metrics.Signatures.WithLabelValues("expired").Inc()
+		return err
+	}
+	result := "digest"
+	err = validateSignature(channelClaimID, signature, signingTS, string(helper.MethodDigest(digest.Method, digest.Fields...)), pubkey)
+	if err != nil {
+		if !config.LegacySignatures || digest.Legacy == "" || validateSignature(channelClaimID, signature, signingTS, digest.Legacy, pubkey) != nil {
+			return err
+		}
+		result = "legacy"
+	}
+	// Only the signatures of calls that change something are recorded, read only calls can reuse theirs. Legacy clients
+	// reuse the signature of their channel name across calls, so the legacy data is not checked for replays either.
+	if !digest.Reusable && result == "digest" {
+		err = checkReplay(channelClaimID, signature, digest)
+		if err != nil {
+			metrics.Signatures.WithLabelValues("replayed").Inc()
+			return err
+		}
+	}
+	metrics.Signatures.WithLabelValues(result).Inc()
+	return nil
+}
+
+// checkSigningTS checks the signature was made within the signing window of now
+func checkSigningTS(signingTS string, now time.Time) error {
+	if config.SigningWindow <= 0 {
+		return nil
+	}
+	ts, err := strconv.ParseInt(signingTS, 10, 64)
+	if err != nil {
+		return api.StatusError{Err: errors.Err("signing_ts must be a unix timestamp"), Status: http.StatusBadRequest}
+	}
+	signedAt := time.Unix(ts, 0)
+	if signedAt.Before(now.Add(-config.SigningWindow)) || signedAt.After(now.Add(config.SigningWindow)) {
+		return api.StatusError{Err: errors.Err("the signature has expired, signing_ts must be within %s of the server time", config.SigningWindow), Status: http.StatusUnauthorized}
+	}
+	return nil
+}
+
+// signatureUses records the signatures used so they cannot be replayed
+type signatureUses interface {
+	// use records the signature as used for the method until it expires, it fails if the signature was already used
+	use(channelClaimID, signatureR, method string, expiry time.Duration) error
+}
+
+// usedSignatures are kept in memory unless Init shares them between the instances through the database
+var usedSignatures signatureUses = &memorySignatureUses{cache: ccache.New(ccache.Configure().MaxSize(100000))}
+
+// checkReplay rejects signatures already used within the signing window. They are kept for two signing windows, as
+// signatures are accepted until one window after their signing_ts which can itself be one window ahead. Only the R
+// half of the signature identifies it, since the S half can be negated to make a different signature that is still
+// valid for the same data.
+func checkReplay(channelClaimID, signature string, digest Digest) error {
+	if config.SigningWindow <= 0 {
+		return nil
+	}
+	r := strings.ToLower(signature)
+	if len(r) > 64 {
+		r = r[:64]
+	}
+	return usedSignatures.use(channelClaimID, r, digest.Method, 2*config.SigningWindow)
+}
+
+func replayed() error {
+	return api.StatusError{Err: errors.Err("this signature was already used, the call must be signed again"), Status: http.StatusUnauthorized}
+}
+
+// memorySignatureUses keeps the used signatures of a single instance
+type memorySignatureUses struct {
+	cache *ccache.Cache
+	mu    sync.Mutex
+}
+
+func (m *memorySignatureUses) use(channelClaimID, signatureR, method string, expiry time.Duration) error {
+	key := channelClaimID + ":" + signatureR
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	item := m.cache.Get(key)
+	if item != nil && !item.Expired() {
+		return replayed()
+	}
+	m.cache.Set(key, method, expiry)
+	return nil
+}
@@ -0,0 +1,174 @@
+package lbry
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/helper"
+
+	"github.com/lbryio/lbry.go/v2/schema/keys"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+func testSigningChannel(t *testing.T) (*Channel, []byte) {
+	private, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkeyBytes, err := keys.PublicKeyToDER(private.PubKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	channel, err := newChannel("@MyTestChannel", "9cb713f01bf247a0e03170b5ed00d5161340c486", private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return channel, pubkeyBytes
+}
+
+// sign signs the data with the channel, signing again when R or S is shorter than 32 bytes since Channel.Sign does not
+// pad them
+func sign(t *testing.T, channel *Channel, data []byte) (string, string) {
+	for {
+		signature, ts, err := channel.Sign(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(signature) == 128 {
+			return signature, ts
+		}
+	}
+}
+
+func TestValidateDigest(t *testing.T) {
+	config.SigningWindow, config.LegacySignatures = 10*time.Minute, false
+	defer func() { config.SigningWindow, config.LegacySignatures = 0, false }()
+	channel, pk := testSigningChannel(t)
+	digest := Call("comment.Abandon", "abc", "abc")
+
+	signature, ts := sign(t, channel, helper.MethodDigest("comment.Abandon", "abc"))
+	err := validateDigest(channel.ChannelID, signature, ts, digest, pk)
+	if err != nil {
+		t.Error(err)
+	}
+	err = validateDigest(channel.ChannelID, signature, ts, digest, pk)
+	if err == nil {
+		t.Error("a replayed signature should be rejected")
+	}
+	err = validateDigest(channel.ChannelID, signature, ts, Call("comment.Abandon", "abc", "def"), pk)
+	if err == nil {
+		t.Error("a signature should not be valid for other arguments")
+	}
+
+	legacy, ts := sign(t, channel, []byte("abc"))
+	err = validateDigest(channel.ChannelID, legacy, ts, digest, pk)
+	if err == nil {
+		t.Error("legacy signatures should be rejected unless allowed")
+	}
+	config.LegacySignatures = true
+	err = validateDigest(channel.ChannelID, legacy, ts, digest, pk)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateDigestReadOnly(t *testing.T) {
+	config.SigningWindow = 10 * time.Minute
+	defer func() { config.SigningWindow = 0 }()
+	channel, pk := testSigningChannel(t)
+	signature, ts := sign(t, channel, helper.MethodDigest("moderation.AmI", channel.ChannelID))
+	for i := 0; i < 2; i++ {
+		err := validateDigest(channel.ChannelID, signature, ts, ReadOnly("moderation.AmI", channel.Name, channel.ChannelID), pk)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestValidateDigestLegacyReadOnly(t *testing.T) {
+	config.SigningWindow, config.LegacySignatures = 10*time.Minute, true
+	defer func() { config.SigningWindow, config.LegacySignatures = 0, false }()
+	channel, pk := testSigningChannel(t)
+	legacy, ts := sign(t, channel, []byte(channel.Name))
+	// legacy clients reuse the signature of their channel name across calls
+	for i := 0; i < 2; i++ {
+		err := validateDigest(channel.ChannelID, legacy, ts, ReadOnly("moderation.AmI", channel.Name, channel.ChannelID), pk)
+		if err != nil {
+			t.Error(err)
+		}
+		err = validateDigest(channel.ChannelID, legacy, ts, Call("setting.Update", channel.Name, channel.Name, channel.ChannelID), pk)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	err := validateDigest(channel.ChannelID, legacy, ts, Call("webhook.Create", "", channel.Name, channel.ChannelID), pk)
+	if err == nil {
+		t.Error("a legacy signature should not be accepted for a method without a legacy form")
+	}
+}
+
+func TestCanonicalArgs(t *testing.T) {
+	type tier struct {
+		Currency  string  `json:"currency"`
+		MinAmount float64 `json:"min_amount"`
+	}
+	type args struct {
+		ChannelName    string  `json:"channel_name"`
+		ChannelID      string  `json:"channel_id"`
+		ModChannelID   string  `json:"mod_channel_id"`
+		Signature      string  `json:"signature"`
+		SigningTS      string  `json:"signing_ts"`
+		Words          *string `json:"words"`
+		SlowModeMinGap *uint64 `json:"slow_mode_min_gap"`
+		Enabled        *bool   `json:"enabled"`
+		Tiers          *[]tier `json:"tiers"`
+	}
+	words := "<b>&"
+	gap := uint64(30)
+	tiers := []tier{{Currency: "USD", MinAmount: 5}}
+	tests := []struct {
+		args      args
+		canonical string
+	}{
+		{args{ChannelName: "@creator", ChannelID: "abc", ModChannelID: "def", Signature: "sig", SigningTS: "1"}, `{}`},
+		{args{ChannelID: "abc", SlowModeMinGap: &gap, Tiers: &tiers}, `{"slow_mode_min_gap":30,"tiers":[{"currency":"USD","min_amount":5}]}`},
+		{args{Words: &words, Enabled: new(bool)}, `{"enabled":false,"words":"<b>&"}`},
+	}
+	for _, test := range tests {
+		canonical, err := CanonicalArgs(test.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if canonical != test.canonical {
+			t.Errorf("expected %s, got %s", test.canonical, canonical)
+		}
+	}
+}
+
+func TestCheckSigningTS(t *testing.T) {
+	config.SigningWindow = 10 * time.Minute
+	defer func() { config.SigningWindow = 0 }()
+	now := time.Now()
+	tests := []struct {
+		signedAt time.Time
+		valid    bool
+	}{
+		{now, true},
+		{now.Add(-9 * time.Minute), true},
+		{now.Add(9 * time.Minute), true},
+		{now.Add(-11 * time.Minute), false},
+		{now.Add(11 * time.Minute), false},
+	}
+	for _, test := range tests {
+		err := checkSigningTS(strconv.FormatInt(test.signedAt.Unix(), 10), now)
+		if (err == nil) != test.valid {
+			t.Errorf("signature made at %s should be valid: %v, got %v", test.signedAt, test.valid, err)
+		}
+	}
+	if checkSigningTS("yesterday", now) == nil {
+		t.Error("signing_ts should have to be a unix timestamp")
+	}
+}
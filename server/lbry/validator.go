@@ -22,8 +22,9 @@ import (
 // ValidateSignatures determines if signatures should be validated or not ( not used yet)
 var ValidateSignatures bool
 
-// ValidateSignature validates the signature was signed by the channel reference.
-func ValidateSignature(channelClaimID, signature, signingTS, data string) error {
+// ValidateSignature validates the signature was signed by the channel reference over the digest of the call, within
+// the signing window and, for calls that change something, that it was not used before.
+func ValidateSignature(channelClaimID, signature, signingTS string, digest Digest) error {
 	channel, err := SDK.GetClaim(channelClaimID)
 	if err != nil {
 		return errors.Err(err)
@@ -40,15 +41,15 @@ func ValidateSignature(channelClaimID, signature, signingTS, data string) error
 	//		return errors.Err("validation is disallowed for non controlling channels")
 	//	}
 	pk := channel.Value.GetChannel().GetPublicKey()
-	err = validateSignature(channelClaimID, signature, signingTS, data, pk)
+	err = validateDigest(channelClaimID, signature, signingTS, digest, pk)
 	if config.IsTestMode {
 		return nil
 	}
 	return err
 }
 
-// ValidateSignatureFromClaim validates the signature was signed by the channel reference.
-func ValidateSignatureFromClaim(channel *jsonrpc.Claim, signature, signingTS, data string) error {
+// ValidateSignatureFromClaim validates the signature was signed by the channel reference over the digest of the call.
+func ValidateSignatureFromClaim(channel *jsonrpc.Claim, signature, signingTS string, digest Digest) error {
 	if channel == nil {
 		return errors.Err("no channel to validate")
 	}
@@ -56,10 +57,24 @@ func ValidateSignatureFromClaim(channel *jsonrpc.Claim, signature, signingTS, da
 		return errors.Err("no channel for public key")
 	}
 	pk := channel.Value.GetChannel().GetPublicKey()
-	return validateSignature(channel.ClaimID, signature, signingTS, data, pk)
+	return validateDigest(channel.ClaimID, signature, signingTS, digest, pk)
 
 }
 
+// VerifyData only checks the data was signed by the channel reference, without checking the signing_ts or replays. It
+// is meant for verifying signatures on behalf of other services, not for authorizing calls.
+func VerifyData(channelClaimID, signature, signingTS, data string) error {
+	channel, err := SDK.GetClaim(channelClaimID)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = validateSignature(channelClaimID, signature, signingTS, data, channel.Value.GetChannel().GetPublicKey())
+	if config.IsTestMode {
+		return nil
+	}
+	return err
+}
+
 // encodePrivateKey encodes an ECDSA private key to PEM format.
 func encodePrivateKey(key *btcec.PrivateKey) ([]byte, error) {
 	derPrivKey, err := keys.PrivateKeyToDER(key)
@@ -0,0 +1,158 @@
+package moderators
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/apikeys"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// Level returns the commentapi.ModLevel* of the channel, 0 if it is not a global moderator
+func Level(channelID string) (int64, error) {
+	moderator, err := model.Moderators(
+		model.ModeratorWhere.ModChannelID.EQ(null.StringFrom(channelID)),
+		qm.OrderBy(model.ModeratorColumns.ModLevel+" DESC")).One(db.RO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errors.Err(err)
+	}
+	return moderator.ModLevel, nil
+}
+
+// RequireLevel returns an error unless the channel is a global moderator of at least level
+func RequireLevel(channel *model.Channel, level int64) error {
+	modLevel, err := Level(channel.ClaimID)
+	if err != nil {
+		return err
+	}
+	if modLevel < level {
+		return api.StatusError{Err: errors.Err("%s must be a global moderator of level %d to do this", channel.Name, level), Status: http.StatusForbidden}
+	}
+	return nil
+}
+
+// Authorize authorizes a call managing global moderators. Calls with an api key that has apikeys.ScopeModerators are
+// authorized without a channel, otherwise the mod channel must have signed the call and be a global moderator of at
+// least level. It returns the claim id of the moderator, empty for api keys.
+func Authorize(r *http.Request, modChannelID, modChannelName string, level int64, signature, signingTS string, digest lbry.Digest) (string, error) {
+	if apikeys.HasScope(r, apikeys.ScopeModerators) {
+		return "", nil
+	}
+	if modChannelID == "" {
+		return "", api.StatusError{Err: errors.Err("an api key with the moderators scope or the signature of a global moderator is required"), Status: http.StatusUnauthorized}
+	}
+	modChannel, err := helper.FindOrCreateChannel(modChannelID, modChannelName)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	err = lbry.ValidateSignature(modChannel.ClaimID, signature, signingTS, digest)
+	if err != nil {
+		return "", err
+	}
+	err = RequireLevel(modChannel, level)
+	if err != nil {
+		return "", err
+	}
+	return modChannel.ClaimID, nil
+}
+
+// Set makes the channel a global moderator of level, or changes the level of an existing one. The actor is the global
+// moderator making the change, empty for the comment server owner or the api key named apiKey.
+func Set(channel *model.Channel, level int64, actorChannelID, apiKey, reason string) error {
+	if level < commentapi.ModLevelBlock || level > commentapi.ModLevelAdmin {
+		return api.StatusError{Err: errors.Err("the moderator level must be between %d and %d", commentapi.ModLevelBlock, commentapi.ModLevelAdmin), Status: http.StatusBadRequest}
+	}
+	return db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		existing, err := model.Moderators(model.ModeratorWhere.ModChannelID.EQ(null.StringFrom(channel.ClaimID)), qm.For("UPDATE")).All(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		var before interface{}
+		if len(existing) > 0 {
+			before = existing[0].ModLevel
+			err = existing.UpdateAll(tx, model.M{model.ModeratorColumns.ModLevel: level})
+		} else {
+			err = (&model.Moderator{ModChannelID: null.StringFrom(channel.ClaimID), ModLevel: level}).Insert(tx, boil.Infer())
+		}
+		if err != nil {
+			return errors.Err(err)
+		}
+		return helper.RecordAction(tx, helper.Audit{
+			Action:          commentapi.ActionAddModerator,
+			ActorChannelID:  actorChannelID,
+			TargetChannelID: channel.ClaimID,
+			Before:          before,
+			After:           level,
+			Reason:          reason,
+			APIKey:          apiKey,
+		})
+	})
+}
+
+// Remove removes the channel from the global moderators. The actor is the global moderator making the change, empty
+// for the comment server owner or the api key named apiKey.
+func Remove(channelID, actorChannelID, apiKey, reason string) error {
+	return db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		existing, err := model.Moderators(model.ModeratorWhere.ModChannelID.EQ(null.StringFrom(channelID)), qm.For("UPDATE")).All(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if len(existing) == 0 {
+			return api.StatusError{Err: errors.Err("channel %s is not a global moderator", channelID), Status: http.StatusBadRequest}
+		}
+		err = existing.DeleteAll(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		return helper.RecordAction(tx, helper.Audit{
+			Action:          commentapi.ActionRemoveModerator,
+			ActorChannelID:  actorChannelID,
+			TargetChannelID: channelID,
+			Before:          existing[0].ModLevel,
+			Reason:          reason,
+			APIKey:          apiKey,
+		})
+	})
+}
+
+// List returns the global moderators, highest level first
+func List() ([]commentapi.GlobalModerator, error) {
+	moderators, err := model.Moderators(
+		qm.Load(model.ModeratorRels.ModChannel),
+		qm.OrderBy(model.ModeratorColumns.ModLevel+" DESC, "+model.ModeratorColumns.ID)).All(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	list := make([]commentapi.GlobalModerator, 0, len(moderators))
+	seen := make(map[string]bool)
+	for _, m := range moderators {
+		if seen[m.ModChannelID.String] {
+			continue
+		}
+		seen[m.ModChannelID.String] = true
+		moderator := commentapi.GlobalModerator{
+			ChannelID: m.ModChannelID.String,
+			Level:     m.ModLevel,
+			CreatedAt: m.CreatedAt,
+		}
+		if m.R != nil && m.R.ModChannel != nil {
+			moderator.ChannelName = m.R.ModChannel.Name
+		}
+		list = append(list, moderator)
+	}
+	return list, nil
+}
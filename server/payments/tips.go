@@ -0,0 +1,107 @@
+package payments
+
+import (
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// tipVerificationWindow is how long after a hyperchat is made its LBC tip keeps getting re-verified
+const tipVerificationWindow = 24 * time.Hour
+
+// requiredConfirmations is the number of confirmations for a tip to be considered confirmed
+const requiredConfirmations = 6
+
+// maxUnconfirmedAge is how long a tip can go without a confirmation before it is reversed
+const maxUnconfirmedAge = 2 * time.Hour
+
+// tipCheck is the result of checking a tip against its support on chain
+type tipCheck struct {
+	Status        string
+	Confirmations uint
+	Amount        uint64
+}
+
+// VerifyTips re-checks the supports of recent LBC hyperchats until they are confirmed, reversing those that were
+// abandoned or never confirmed. Tips made before the output of the support was stored are not checked.
+func VerifyTips() error {
+	since := time.Now().Add(-tipVerificationWindow)
+	comments, err := m.Comments(
+		m.CommentWhere.IsFiat.EQ(false),
+		m.CommentWhere.Timestamp.GTE(int(since.Unix())),
+		m.CommentWhere.TXID.IsNotNull(),
+		m.CommentWhere.TXVout.IsNotNull(),
+		m.CommentWhere.Amount.GT(null.Uint64From(0)),
+		qm.Where("("+m.CommentColumns.PaymentStatus+" IS NULL OR "+m.CommentColumns.PaymentStatus+" NOT IN (?, ?))",
+			commentapi.PaymentReversed, commentapi.PaymentConfirmed),
+	).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, c := range comments {
+		err := verifyTip(c)
+		if err != nil {
+			logrus.Error("Tip Verification: ", errors.FullTrace(err))
+		}
+	}
+	return nil
+}
+
+func verifyTip(comment *m.Comment) error {
+	status, err := lbry.SDK.GetSupportStatus(comment.TXID.String, uint64(comment.TXVout.Uint))
+	if err != nil {
+		return errors.Prefix("could not get support "+comment.TXID.String, err)
+	}
+	if status == nil {
+		return nil
+	}
+	check := checkTip(comment, status, time.Now())
+	if check.Status == comment.PaymentStatus.String && check.Confirmations == comment.TXConfirmations.Uint && check.Amount == comment.Amount.Uint64 {
+		return nil
+	}
+
+	publish := check.Status == commentapi.PaymentReversed || check.Amount != comment.Amount.Uint64
+	previousAmount := comment.Amount.Uint64
+	comment.PaymentStatus.SetValid(check.Status)
+	comment.TXConfirmations.SetValid(check.Confirmations)
+	comment.Amount.SetValid(check.Amount)
+	if check.Status == commentapi.PaymentReversed {
+		comment.PinnedUntil.Valid = false
+	}
+	return savePaymentUpdate(comment, previousAmount, publish)
+}
+
+// checkTip decides the state of a tip from its support on chain
+func checkTip(comment *m.Comment, status *lbry.SupportStatus, now time.Time) tipCheck {
+	age := now.Sub(time.Unix(int64(comment.Timestamp), 0))
+	if !status.Found {
+		if age > maxUnconfirmedAge {
+			return tipCheck{Status: commentapi.PaymentReversed}
+		}
+		return tipCheck{Status: commentapi.PaymentPending, Amount: comment.Amount.Uint64}
+	}
+	confirmations := uint(0)
+	if status.Confirmations > 0 {
+		confirmations = uint(status.Confirmations)
+	}
+	if status.IsSpent || (confirmations == 0 && age > maxUnconfirmedAge) {
+		return tipCheck{Status: commentapi.PaymentReversed, Confirmations: confirmations}
+	}
+	amount := comment.Amount.Uint64
+	if status.Amount < amount {
+		amount = status.Amount
+	}
+	if confirmations < requiredConfirmations {
+		return tipCheck{Status: commentapi.PaymentPending, Confirmations: confirmations, Amount: amount}
+	}
+	return tipCheck{Status: commentapi.PaymentConfirmed, Confirmations: confirmations, Amount: amount}
+}
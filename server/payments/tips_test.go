@@ -0,0 +1,41 @@
+package payments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/volatiletech/null"
+)
+
+func TestCheckTip(t *testing.T) {
+	now := time.Now()
+	recent := &m.Comment{Timestamp: int(now.Add(-10 * time.Minute).Unix()), Amount: null.Uint64From(500000000)}
+	old := &m.Comment{Timestamp: int(now.Add(-3 * time.Hour).Unix()), Amount: null.Uint64From(500000000)}
+
+	tests := []struct {
+		name     string
+		comment  *m.Comment
+		status   lbry.SupportStatus
+		expected tipCheck
+	}{
+		{"missing recent", recent, lbry.SupportStatus{}, tipCheck{Status: commentapi.PaymentPending, Amount: 500000000}},
+		{"missing old", old, lbry.SupportStatus{}, tipCheck{Status: commentapi.PaymentReversed}},
+		{"unconfirmed recent", recent, lbry.SupportStatus{Found: true, Amount: 500000000}, tipCheck{Status: commentapi.PaymentPending, Amount: 500000000}},
+		{"unconfirmed old", old, lbry.SupportStatus{Found: true, Amount: 500000000}, tipCheck{Status: commentapi.PaymentReversed}},
+		{"confirming", recent, lbry.SupportStatus{Found: true, Confirmations: 2, Amount: 500000000}, tipCheck{Status: commentapi.PaymentPending, Confirmations: 2, Amount: 500000000}},
+		{"confirmed", old, lbry.SupportStatus{Found: true, Confirmations: 12, Amount: 500000000}, tipCheck{Status: commentapi.PaymentConfirmed, Confirmations: 12, Amount: 500000000}},
+		{"abandoned", old, lbry.SupportStatus{Found: true, Confirmations: 12, IsSpent: true, Amount: 500000000}, tipCheck{Status: commentapi.PaymentReversed, Confirmations: 12}},
+		{"smaller support", recent, lbry.SupportStatus{Found: true, Confirmations: 7, Amount: 100000000}, tipCheck{Status: commentapi.PaymentConfirmed, Confirmations: 7, Amount: 100000000}},
+	}
+	for _, test := range tests {
+		status := test.status
+		check := checkTip(test.comment, &status, now)
+		if check != test.expected {
+			t.Errorf("%s: expected %+v got %+v", test.name, test.expected, check)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package payments
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/webhooks"
+	"github.com/lbryio/commentron/server/websocket"
+	"github.com/lbryio/commentron/sockety"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/lbryio/sockety/socketyapi"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/webhook"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// maxPayloadSize is the largest webhook payload accepted
+const maxPayloadSize = 65536
+
+var errUnverified = errors.Base("stripe webhook signature could not be verified")
+
+// paymentUpdate is the change to a hyperchat caused by a stripe event
+type paymentUpdate struct {
+	PaymentIntentID string
+	Status          string
+	// Amount of the charge in cents, 0 when the event does not include it and the hyperchat amount is used instead
+	ChargeAmount uint64
+	// Amount of the charge that was refunded or disputed in cents
+	ReversedAmount uint64
+}
+
+// StripeWebhook handles the stripe events for refunds and disputes of fiat hyperchats
+func StripeWebhook() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+		if err != nil {
+			http.Error(w, "could not read payload", http.StatusServiceUnavailable)
+			return
+		}
+		update, err := parseEvent(payload, r.Header.Get("Stripe-Signature"), config.WebhookSecrets())
+		if errors.Is(err, errUnverified) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		} else if err != nil {
+			logrus.Error("Stripe Webhook: ", errors.FullTrace(err))
+			http.Error(w, "could not parse event", http.StatusBadRequest)
+			return
+		}
+		if update == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		err = applyUpdate(update)
+		if err != nil {
+			logrus.Error("Stripe Webhook: ", errors.FullTrace(err))
+			http.Error(w, "could not apply event", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// parseEvent verifies the event against each secret and returns the update it makes, nil if it is not an event of interest
+func parseEvent(payload []byte, signature string, secrets []string) (*paymentUpdate, error) {
+	var event stripe.Event
+	verified := false
+	for _, secret := range secrets {
+		var err error
+		event, err = webhook.ConstructEvent(payload, signature, secret)
+		if err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.Err(errUnverified)
+	}
+
+	switch event.Type {
+	case "charge.refunded":
+		var charge stripe.Charge
+		err := json.Unmarshal(event.Data.Raw, &charge)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		if charge.PaymentIntent == "" {
+			return nil, nil
+		}
+		status := commentapi.PaymentPartiallyRefunded
+		if charge.Refunded || charge.AmountRefunded >= charge.Amount {
+			status = commentapi.PaymentRefunded
+		}
+		return &paymentUpdate{
+			PaymentIntentID: charge.PaymentIntent,
+			Status:          status,
+			ChargeAmount:    uint64(charge.Amount),
+			ReversedAmount:  uint64(charge.AmountRefunded),
+		}, nil
+	case "charge.dispute.created":
+		var dispute stripe.Dispute
+		err := json.Unmarshal(event.Data.Raw, &dispute)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		if dispute.PaymentIntent == nil || dispute.PaymentIntent.ID == "" {
+			return nil, nil
+		}
+		// The charge is only included when it is expanded, the disputed amount always is
+		update := &paymentUpdate{
+			PaymentIntentID: dispute.PaymentIntent.ID,
+			Status:          commentapi.PaymentDisputed,
+			ReversedAmount:  uint64(dispute.Amount),
+		}
+		if dispute.Charge != nil {
+			update.ChargeAmount = uint64(dispute.Charge.Amount)
+		}
+		return update, nil
+	}
+	return nil, nil
+}
+
+// apply sets the remaining amount and status of the hyperchat. It is only unpinned once the whole charge was refunded
+// or disputed. Without the charge amount the reversed amount is taken off the hyperchat amount, so a redelivered event
+// is not taken off twice.
+func (u *paymentUpdate) apply(comment *m.Comment) {
+	charge := u.ChargeAmount
+	if charge == 0 {
+		if comment.PaymentStatus.String == u.Status {
+			return
+		}
+		charge = comment.Amount.Uint64
+	}
+	var remaining uint64
+	if u.ReversedAmount < charge {
+		remaining = charge - u.ReversedAmount
+	}
+	comment.Amount.SetValid(remaining)
+	comment.PaymentStatus.SetValid(u.Status)
+	if remaining == 0 {
+		comment.PinnedUntil.Valid = false
+	}
+}
+
+func applyUpdate(update *paymentUpdate) error {
+	comment, err := m.Comments(m.CommentWhere.PaymentIntentID.EQ(null.StringFrom(update.PaymentIntentID))).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) {
+		logrus.Warningf("Stripe Webhook: no hyperchat found for payment intent %s", update.PaymentIntentID)
+		return nil
+	}
+	if err != nil {
+		return errors.Err(err)
+	}
+	previousAmount := comment.Amount.Uint64
+	update.apply(comment)
+	return savePaymentUpdate(comment, previousAmount, true)
+}
+
+// savePaymentUpdate saves the payment of a hyperchat, letting live chat, internal-apis and webhooks know its amount or
+// status changed if it should be published. Only the payment columns are written, the rest of the comment may have
+// changed since it was loaded. The amount taken off the hyperchat since it was loaded is taken off the creator's stats.
+func savePaymentUpdate(comment *m.Comment, previousAmount uint64, publish bool) error {
+	err := db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := comment.Update(tx, boil.Whitelist(m.CommentColumns.Amount, m.CommentColumns.PaymentStatus, m.CommentColumns.PinnedUntil,
+			m.CommentColumns.TXConfirmations))
+		if err != nil {
+			return errors.Err(err)
+		}
+		// Flagged hyperchats were never counted in the stats
+		if !comment.IsFlagged && comment.Amount.Uint64 < previousAmount {
+			err = rollup.ReverseTip(tx, comment, previousAmount-comment.Amount.Uint64, comment.Amount.Uint64 == 0)
+			if err != nil {
+				return err
+			}
+		}
+		if !publish {
+			return nil
+		}
+		err = webhooks.Publish(tx, commentapi.WebhookHyperchat, comment.CreatorChannelID.String, paymentData(comment))
+		if err != nil {
+			return err
+		}
+		return lbry.QueueNotification(tx, lbry.NotifyOptions{
+			ActionType:    "U",
+			CommentID:     comment.CommentID,
+			ChannelID:     &comment.ChannelID.String,
+			ParentID:      &comment.ParentID.String,
+			ClaimID:       comment.LbryClaimID,
+			Amount:        comment.Amount.Uint64,
+			IsFiat:        comment.IsFiat,
+			Currency:      &comment.Currency.String,
+			PaymentStatus: &comment.PaymentStatus.String,
+		})
+	})
+	if err != nil {
+		return errors.Err(err)
+	}
+	if publish {
+		go pushPaymentUpdate(comment)
+	}
+	return nil
+}
+
+// paymentData is the data pushed to live chat and webhooks when the payment of a hyperchat changes
+func paymentData(comment *m.Comment) map[string]interface{} {
+	currency := helper.TickerCurrency(comment)
+	return map[string]interface{}{
+		"comment_id":     comment.CommentID,
+		"claim_id":       comment.LbryClaimID,
+		"support_amount": helper.FromBaseUnits(currency, comment.Amount.Uint64),
+		"currency":       currency,
+		"payment_status": comment.PaymentStatus.String,
+	}
+}
+
+func pushPaymentUpdate(comment *m.Comment) {
+	data := paymentData(comment)
+	websocket.PushTo(&websocket.PushNotification{
+		Type: "payment_update",
+		Data: data,
+	}, comment.LbryClaimID)
+
+	go sockety.SendNotification(socketyapi.SendNotificationArgs{
+		Service: socketyapi.Commentron,
+		Type:    "payment_update",
+		IDs:     []string{comment.LbryClaimID, "comments"},
+		Data:    data,
+	})
+}
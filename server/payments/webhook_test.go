@@ -0,0 +1,97 @@
+package payments
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/stripe/stripe-go/webhook"
+	"github.com/volatiletech/null"
+)
+
+const testSecret = "whsec_test_secret"
+
+func signedPayload(t *testing.T, file, secret string) ([]byte, string) {
+	payload, err := ioutil.ReadFile("testdata/" + file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	signature := webhook.ComputeSignature(now, payload, secret)
+	return payload, fmt.Sprintf("t=%d,v1=%x", now.Unix(), signature)
+}
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		file     string
+		expected *paymentUpdate
+	}{
+		{"charge_refunded.json", &paymentUpdate{PaymentIntentID: "pi_3JqFid2eZvKYlo2C1lM7hOwc", Status: commentapi.PaymentPartiallyRefunded, ChargeAmount: 1000, ReversedAmount: 400}},
+		{"charge_dispute_created.json", &paymentUpdate{PaymentIntentID: "pi_3JqGjz2eZvKYlo2C0dV7cM1P", Status: commentapi.PaymentDisputed, ChargeAmount: 500, ReversedAmount: 500}},
+		{"charge_dispute_created_unexpanded.json", &paymentUpdate{PaymentIntentID: "pi_3JqGjz2eZvKYlo2C0dV7cM1P", Status: commentapi.PaymentDisputed, ReversedAmount: 300}},
+		{"payment_intent_succeeded.json", nil},
+	}
+	for _, test := range tests {
+		payload, signature := signedPayload(t, test.file, testSecret)
+		update, err := parseEvent(payload, signature, []string{"whsec_other", testSecret})
+		if err != nil {
+			t.Fatalf("%s: %s", test.file, err)
+		}
+		if test.expected == nil && update != nil {
+			t.Errorf("%s: expected no update got %+v", test.file, update)
+		}
+		if test.expected != nil && (update == nil || *update != *test.expected) {
+			t.Errorf("%s: expected %+v got %+v", test.file, test.expected, update)
+		}
+	}
+}
+
+func TestApplyUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    uint64
+		status    string
+		update    paymentUpdate
+		remaining uint64
+		pinned    bool
+	}{
+		{"partial refund", 1000, "", paymentUpdate{Status: commentapi.PaymentPartiallyRefunded, ChargeAmount: 1000, ReversedAmount: 400}, 600, true},
+		{"second partial refund", 600, commentapi.PaymentPartiallyRefunded, paymentUpdate{Status: commentapi.PaymentPartiallyRefunded, ChargeAmount: 1000, ReversedAmount: 700}, 300, true},
+		{"full refund", 600, commentapi.PaymentPartiallyRefunded, paymentUpdate{Status: commentapi.PaymentRefunded, ChargeAmount: 1000, ReversedAmount: 1000}, 0, false},
+		{"partial dispute without charge", 500, "", paymentUpdate{Status: commentapi.PaymentDisputed, ReversedAmount: 300}, 200, true},
+		{"redelivered dispute without charge", 200, commentapi.PaymentDisputed, paymentUpdate{Status: commentapi.PaymentDisputed, ReversedAmount: 300}, 200, true},
+		{"full dispute without charge", 500, "", paymentUpdate{Status: commentapi.PaymentDisputed, ReversedAmount: 500}, 0, false},
+	}
+	for _, test := range tests {
+		comment := &m.Comment{
+			Amount:        null.Uint64From(test.amount),
+			PaymentStatus: null.NewString(test.status, test.status != ""),
+			PinnedUntil:   null.Uint64From(uint64(time.Now().Add(time.Hour).Unix())),
+		}
+		test.update.apply(comment)
+		if comment.Amount.Uint64 != test.remaining || comment.PaymentStatus.String != test.update.Status {
+			t.Errorf("%s: expected %d %s got %d %s", test.name, test.remaining, test.update.Status, comment.Amount.Uint64, comment.PaymentStatus.String)
+		}
+		if comment.PinnedUntil.Valid != test.pinned {
+			t.Errorf("%s: expected pinned %t got %t", test.name, test.pinned, comment.PinnedUntil.Valid)
+		}
+	}
+}
+
+func TestParseEventUnverified(t *testing.T) {
+	payload, signature := signedPayload(t, "charge_refunded.json", "whsec_wrong")
+	_, err := parseEvent(payload, signature, []string{testSecret})
+	if !errors.Is(err, errUnverified) {
+		t.Errorf("expected unverified error got %v", err)
+	}
+	_, err = parseEvent(payload, signature, nil)
+	if !errors.Is(err, errUnverified) {
+		t.Errorf("expected unverified error without secrets got %v", err)
+	}
+}
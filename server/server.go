@@ -22,6 +22,9 @@ import (
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/server/apikeys"
+	"github.com/lbryio/commentron/server/jobs"
+	"github.com/lbryio/commentron/server/payments"
 	"github.com/lbryio/commentron/server/services/v1/comments"
 	rpcHack "github.com/lbryio/commentron/server/services/v1/rpc"
 	jsonHack "github.com/lbryio/commentron/server/services/v1/rpc/json"
@@ -30,7 +33,9 @@ import (
 	"github.com/lbryio/commentron/server/services/v2/moderation"
 	"github.com/lbryio/commentron/server/services/v2/reactions"
 	"github.com/lbryio/commentron/server/services/v2/settings"
+	"github.com/lbryio/commentron/server/services/v2/stats"
 	"github.com/lbryio/commentron/server/services/v2/verify"
+	"github.com/lbryio/commentron/server/services/v2/webhooks"
 
 	"github.com/lbryio/lbry.go/extras/api"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
@@ -66,8 +71,10 @@ func Start() {
 	router.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	router.Handle("/api", v1RPCServer())
 	router.Handle("/api/v1", v1RPCServer())
-	router.Handle("/api/v2", chain.Then(v2RPCServer()))
+	// api keys are only accepted by the v2 api
+	router.Handle("/api/v2", chain.Append(apikeys.Middleware).Then(v2RPCServer()))
 	router.Handle("/api/v2/live-chat/subscribe", websocket.SubscribeLiveChat())
+	router.Handle("/api/v2/stripe/webhook", payments.StripeWebhook()).Methods(http.MethodPost)
 	router.Handle(promPath, promBasicAuthWrapper(promhttp.Handler()))
 
 	mux := http.Handler(router)
@@ -77,6 +84,8 @@ func Start() {
 		mux = middleware(mux)
 	}
 
+	jobs.Start()
+
 	logrus.Infof("Running RPC Server @ http://%s:%d/api", RPCHost, RPCPort)
 	address := fmt.Sprintf("%s:%d", RPCHost, RPCPort)
 	logrus.Fatal(http.ListenAndServe(address, mux))
@@ -208,6 +217,8 @@ func v2RPCServer() http.Handler {
 	settingService := new(settings.Service)
 	verifyService := new(verify.Service)
 	blockedlistService := new(blockedlists.Service)
+	statsService := new(stats.Service)
+	webhookService := new(webhooks.Service)
 
 	err := rpcServer.RegisterService(commentService, "comment")
 	if err != nil {
@@ -237,6 +248,14 @@ func v2RPCServer() http.Handler {
 	if err != nil {
 		logrus.Panicf("Error registering v2 verify service: %s", errors.FullTrace(err))
 	}
+	err = rpcServer.RegisterService(statsService, "stats")
+	if err != nil {
+		logrus.Panicf("Error registering v2 stats service: %s", errors.FullTrace(err))
+	}
+	err = rpcServer.RegisterService(webhookService, "webhook")
+	if err != nil {
+		logrus.Panicf("Error registering v2 webhook service: %s", errors.FullTrace(err))
+	}
 	rpcServer.RegisterBeforeFunc(func(info *rpc.RequestInfo) {
 		logrus.Debugf("M->%s: from %s, %d", info.Method, getIP(info.Request), info.StatusCode)
 	})
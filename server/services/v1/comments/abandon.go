@@ -12,6 +12,8 @@ import (
 	"github.com/lbryio/lbry.go/extras/api"
 	"github.com/lbryio/lbry.go/extras/util"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/boil"
 )
 
 func abandon(args *commentapi.AbandonArgs) (*commentapi.CommentItem, error) {
@@ -20,6 +22,7 @@ func abandon(args *commentapi.AbandonArgs) (*commentapi.CommentItem, error) {
 		return nil, errors.Err(err)
 	}
 	var channel *model.Channel
+	var signer *model.Channel
 	if args.CreatorChannelID != nil && args.CreatorChannelName != nil {
 		channel, err = helper.FindOrCreateChannel(util.StrFromPtr(args.CreatorChannelID), util.StrFromPtr(args.CreatorChannelName))
 		if err != nil {
@@ -36,19 +39,54 @@ func abandon(args *commentapi.AbandonArgs) (*commentapi.CommentItem, error) {
 		if signingChannelClaimID != channel.ClaimID {
 			return nil, api.StatusError{Err: errors.Err("you do not have creator authorizations to remove this comment on %s", comment.LbryClaimID), Status: http.StatusBadRequest}
 		}
+		signer = channel
+		if args.ModChannelID != nil && args.ModChannelName != nil {
+			signer, _, err = helper.GetModerator(*args.ModChannelID, *args.ModChannelName, channel.ClaimID, channel.Name, comment.LbryClaimID, commentapi.PermissionDelete)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		channel, err = model.Channels(model.ChannelWhere.ClaimID.EQ(comment.ChannelID.String)).One(db.RO)
 		if err != nil {
 			return nil, errors.Err(err)
 		}
+		signer = channel
 	}
 
-	err = lbry.ValidateSignature(channel.ClaimID, args.Signature, args.SigningTS, args.CommentID)
+	err = lbry.ValidateSignature(signer.ClaimID, args.Signature, args.SigningTS, lbry.Call("comment.Abandon", args.CommentID, args.CommentID))
 	if err != nil {
 		return nil, err
 	}
-	item := populateItem(comment, channel, 0)
-	err = comment.Delete(db.RW)
+	item := populateItem(comment, channel)
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := comment.Delete(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if comment.ParentID.Valid {
+			err = helper.AddReplies(tx, comment.ParentID.String, -1)
+			if err != nil {
+				return err
+			}
+		}
+		if args.CreatorChannelID != nil && args.CreatorChannelName != nil {
+			err = helper.RecordAction(tx, helper.Audit{
+				Action:           commentapi.ActionDelete,
+				ActorChannelID:   signer.ClaimID,
+				CreatorChannelID: channel.ClaimID,
+				TargetChannelID:  comment.ChannelID.String,
+				ClaimID:          comment.LbryClaimID,
+				CommentID:        comment.CommentID,
+				Before:           item,
+				Reason:           args.Reason,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return queueNotifications(tx, actionDelete, comment, item)
+	})
 	if err != nil {
 		return nil, errors.Err(err)
 	}
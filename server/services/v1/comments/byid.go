@@ -26,10 +26,6 @@ func byID(_ *http.Request, args *commentapi.ByIDArgs) (commentapi.CommentItem, [
 	if comment.R != nil && comment.R.Channel != nil {
 		channel = comment.R.Channel
 	}
-	replies, err := comment.ParentComments().Count(db.RO)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return commentapi.CommentItem{}, nil, errors.Err(err)
-	}
 	var ancestors []commentapi.CommentItem
 	if args.WithAncestors {
 		lastcomment := comment
@@ -42,14 +38,10 @@ func byID(_ *http.Request, args *commentapi.ByIDArgs) (commentapi.CommentItem, [
 			if parentComment.R != nil && parentComment.R.Channel != nil {
 				parentChannel = parentComment.R.Channel
 			}
-			parentReplies, err := comment.ParentComments().Count(db.RO)
-			if err != nil && errors.Is(err, sql.ErrNoRows) {
-				return commentapi.CommentItem{}, nil, errors.Err(err)
-			}
-			ancestors = append(ancestors, populateItem(parentComment, parentChannel, int(parentReplies)))
+			ancestors = append(ancestors, populateItem(parentComment, parentChannel))
 			lastcomment = parentComment
 		}
 	}
 
-	return populateItem(comment, channel, int(replies)), ancestors, nil
+	return populateItem(comment, channel), ancestors, nil
 }
@@ -0,0 +1,77 @@
+package comments
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/apikeys"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// changeGapTimeout is how long a gap in the seqs of the change log is waited on before the changes after it are
+// returned. Gaps are left by transactions that got their seq but did not commit yet, which fill them once they do, and
+// by rolled back transactions, which never do.
+const changeGapTimeout = time.Minute
+
+func changes(r *http.Request, args *commentapi.ChangesArgs, reply *commentapi.ChangesResponse) error {
+	if !apikeys.HasScope(r, apikeys.ScopeChanges) {
+		return api.StatusError{Err: errors.Err("an api key with the changes scope is required"), Status: http.StatusUnauthorized}
+	}
+	args.ApplyDefaults()
+	// read from the primary, a replica lagging behind would leave gaps that are not waited on
+	entries, err := m.CommentChanges(
+		m.CommentChangeWhere.Seq.GT(args.Since),
+		qm.OrderBy(m.CommentChangeColumns.Seq),
+		qm.Limit(args.Limit+1)).All(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if len(entries) > args.Limit {
+		entries = entries[:args.Limit]
+		reply.HasMore = true
+	}
+	settled := untilGap(entries, args.Since, time.Now())
+	if settled < len(entries) {
+		entries = entries[:settled]
+		reply.HasMore = false
+	}
+	reply.Changes = make([]commentapi.Change, len(entries))
+	reply.Next = args.Since
+	for i, entry := range entries {
+		reply.Changes[i] = commentapi.Change{
+			Seq:       entry.Seq,
+			Kind:      entry.Kind,
+			CommentID: entry.CommentID.String,
+			ClaimID:   entry.ClaimID.String,
+			ChannelID: entry.ChannelID.String,
+			Timestamp: entry.CreatedAt.Unix(),
+		}
+		if entry.Data.Valid {
+			reply.Changes[i].Data = json.RawMessage(entry.Data.String)
+		}
+		reply.Next = entry.Seq
+	}
+	return nil
+}
+
+// untilGap returns how many of the entries come before a recent gap in their seqs, the changes after it are held back
+// until the gap is filled or times out
+func untilGap(entries m.CommentChangeSlice, since uint64, now time.Time) int {
+	expected := since + 1
+	for i, entry := range entries {
+		if since > 0 && entry.Seq != expected && now.Sub(entry.CreatedAt) < changeGapTimeout {
+			return i
+		}
+		since = entry.Seq
+		expected = entry.Seq + 1
+	}
+	return len(entries)
+}
@@ -0,0 +1,44 @@
+package comments
+
+import (
+	"testing"
+	"time"
+
+	m "github.com/lbryio/commentron/model"
+)
+
+func TestUntilGap(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-time.Second)
+	old := now.Add(-2 * changeGapTimeout)
+	entries := func(seqs ...uint64) m.CommentChangeSlice {
+		var slice m.CommentChangeSlice
+		for _, seq := range seqs {
+			createdAt := recent
+			if seq >= 100 {
+				createdAt = old
+			}
+			slice = append(slice, &m.CommentChange{Seq: seq, CreatedAt: createdAt})
+		}
+		return slice
+	}
+
+	tests := []struct {
+		name     string
+		entries  m.CommentChangeSlice
+		since    uint64
+		expected int
+	}{
+		{"contiguous", entries(11, 12, 13), 10, 3},
+		{"gap before the first", entries(12, 13), 10, 0},
+		{"gap in the middle", entries(11, 12, 14, 15), 10, 2},
+		{"old gap", entries(11, 12, 100, 101), 10, 4},
+		{"start of the log", entries(5, 6, 8), 0, 2},
+		{"empty", entries(), 10, 0},
+	}
+	for _, test := range tests {
+		if settled := untilGap(test.entries, test.since, now); settled != test.expected {
+			t.Errorf("%s: expected %d settled changes, got %d", test.name, test.expected, settled)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package comments
+
+import (
+	"database/sql"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// emoteRegex matches a comment made up of nothing but emotes, ie `:fire: :lbc:`
+var emoteRegex = regexp.MustCompile(`^(\s*:[A-Za-z0-9_\-+]+:\s*)+$`)
+
+func checkChatModes(settings *m.CreatorSetting, request *createRequest) error {
+	if settings.EmoteOnly.Bool && !isEmoteOnly(request.args.CommentText) {
+		return api.StatusError{Err: errors.Err("emote only mode is on, %s only allows emotes in chat", request.creatorChannel.Name), Status: http.StatusBadRequest}
+	}
+	if !settings.TimeSinceFirstComment.IsZero() {
+		err := checkTimeSinceFirstComment(request.args.ChannelID, time.Duration(settings.TimeSinceFirstComment.Uint64)*time.Minute)
+		if err != nil {
+			return err
+		}
+	}
+	if settings.SupportersOnly.Bool && request.comment.Amount.IsZero() {
+		isSupporter, err := hasSupportedCreator(request.args.ChannelID, request.creatorChannel.ClaimID)
+		if err != nil {
+			return err
+		}
+		if !isSupporter {
+			return api.StatusError{Err: errors.Err("supporters only mode is on, you must have tipped %s before to comment", request.creatorChannel.Name), Status: http.StatusBadRequest}
+		}
+	}
+	return nil
+}
+
+func isEmoteOnly(comment string) bool {
+	return emoteRegex.MatchString(strings.TrimSpace(comment))
+}
+
+func checkTimeSinceFirstComment(channelID string, minAge time.Duration) error {
+	firstComment, err := m.Comments(m.CommentWhere.ChannelID.EQ(null.StringFrom(channelID)), qm.OrderBy(m.CommentColumns.Timestamp+" ASC")).One(db.RO)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Err(err)
+	}
+	if firstComment == nil {
+		return api.StatusError{Err: errors.Err("the creator requires you to have commented before for at least %d minutes", int(minAge.Minutes())), Status: http.StatusBadRequest}
+	}
+	commentingFor := time.Since(time.Unix(int64(firstComment.Timestamp), 0))
+	if commentingFor < minAge {
+		minutesLeft := int((minAge - commentingFor).Minutes()) + 1
+		return api.StatusError{Err: errors.Err("the creator requires you to have commented for at least %d minutes, please wait %d more minutes", int(minAge.Minutes()), minutesLeft), Status: http.StatusBadRequest}
+	}
+	return nil
+}
+
+// hasSupportedCreator checks whether the channel has ever tipped one of the creator's claims through a hyperchat
+func hasSupportedCreator(channelID, creatorChannelID string) (bool, error) {
+	supported, err := m.Comments(
+		m.CommentWhere.ChannelID.EQ(null.StringFrom(channelID)),
+		m.CommentWhere.CreatorChannelID.EQ(null.StringFrom(creatorChannelID)),
+		m.CommentWhere.Amount.GT(null.Uint64From(0))).Exists(db.RO)
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return supported, nil
+}
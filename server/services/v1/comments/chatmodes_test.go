@@ -0,0 +1,27 @@
+package comments
+
+import "testing"
+
+func TestIsEmoteOnly(t *testing.T) {
+	tests := []struct {
+		comment  string
+		expected bool
+	}{
+		{":fire:", true},
+		{"  :fire: :lbc:  ", true},
+		{":fire::lbc:", true},
+		{":thumbs_up: :+1: :smile-cat:", true},
+		{"", false},
+		{"hello", false},
+		{":fire: hello", false},
+		{"hello :fire:", false},
+		{":fire", false},
+		{"::", false},
+		{":fire :lbc:", false},
+	}
+	for _, test := range tests {
+		if isEmoteOnly(test.comment) != test.expected {
+			t.Errorf("%q: expected %t", test.comment, test.expected)
+		}
+	}
+}
@@ -12,7 +12,7 @@ import (
 
 var currencyMap = map[string]uint64{"USD": 100}
 
-func populateItem(comment *m.Comment, channel *m.Channel, replies int) commentapi.CommentItem {
+func populateItem(comment *m.Comment, channel *m.Channel) commentapi.CommentItem {
 	var channelName null.String
 	var channelURL null.String
 	if channel != nil {
@@ -41,10 +41,16 @@ func populateItem(comment *m.Comment, channel *m.Channel, replies int) commentap
 		ChannelID:     comment.ChannelID.String,
 		ChannelName:   channelName.String,
 		ChannelURL:    channelURL.String,
-		Replies:       replies,
+		Replies:       comment.ReplyCount,
 		SupportAmount: supportAmount,
 		IsFiat:        comment.IsFiat,
 		Currency:      comment.Currency.String,
+		PinnedUntil:   comment.PinnedUntil.Uint64,
+		PaymentStatus: comment.PaymentStatus.String,
+	}
+	if comment.CreatorLikedBy.Valid {
+		item.CreatorLiked = true
+		item.CreatorLikedBy = comment.CreatorLikedBy.String
 	}
 
 	return item
@@ -15,6 +15,7 @@ import (
 	"github.com/lbryio/commentron/flags"
 	"github.com/lbryio/commentron/helper"
 	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
 	"github.com/lbryio/commentron/server/lbry"
 	"github.com/lbryio/commentron/server/websocket"
 	"github.com/lbryio/commentron/sockety"
@@ -82,17 +83,45 @@ func create(_ *http.Request, args *commentapi.CreateArgs, reply *commentapi.Crea
 		return err
 	}
 
+	if request.signingChannel != nil {
+		request.comment.CreatorChannelID.SetValid(request.signingChannel.ClaimID)
+	}
+
+	err = applyTickerExpiry(request)
+	if err != nil {
+		return err
+	}
+
 	err = flags.CheckComment(request.comment)
 	if err != nil {
 		return err
 	}
 
-	err = request.comment.Insert(db.RW, boil.Infer())
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := request.comment.Insert(tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		if request.comment.ParentID.Valid {
+			err = helper.AddReplies(tx, request.comment.ParentID.String, 1)
+			if err != nil {
+				return err
+			}
+		}
+		err = rollup.Comment(tx, request.comment)
+		if err != nil {
+			return err
+		}
+		if request.comment.IsFlagged {
+			return nil
+		}
+		return queueNotifications(tx, actionCreate, request.comment, populateItem(request.comment, channel))
+	})
 	if err != nil {
 		return err
 	}
 
-	item := populateItem(request.comment, channel, 0)
+	item := populateItem(request.comment, channel)
 
 	err = applyModStatus(&item, args.ChannelID, args.ClaimID)
 	if err != nil {
@@ -102,21 +131,9 @@ func create(_ *http.Request, args *commentapi.CreateArgs, reply *commentapi.Crea
 	reply.CommentItem = &item
 	if !request.comment.IsFlagged {
 		go pushItem(item, args.ClaimID)
-		amount, err := btcutil.NewAmount(item.SupportAmount)
-		if err != nil {
-			return errors.Err(err)
+		if request.comment.PinnedUntil.Valid {
+			go pushTickerStart(item, args.ClaimID)
 		}
-		go lbry.API.Notify(lbry.NotifyOptions{
-			ActionType: "C",
-			CommentID:  item.CommentID,
-			ChannelID:  &item.ChannelID,
-			ParentID:   &item.ParentID,
-			Comment:    &item.Comment,
-			ClaimID:    item.ClaimID,
-			Amount:     uint64(amount),
-			IsFiat:     item.IsFiat,
-			Currency:   util.PtrToString(item.Currency),
-		})
 	}
 
 	return nil
@@ -138,6 +155,8 @@ func createComment(request *createRequest) error {
 		Signingts:   null.StringFrom(request.args.SigningTS),
 		Timestamp:   int(timestamp),
 	}
+	created := time.Unix(int64(request.comment.Timestamp), 0)
+	request.comment.HotScore = helper.HotScore(0, 0, created, created)
 	return nil
 }
 
@@ -158,7 +177,7 @@ func checkAllowedAndValidate(args *commentapi.CreateArgs) error {
 		}
 	}
 
-	err = lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, args.CommentText)
+	err = lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, lbry.Call("comment.Create", args.CommentText, args.ClaimID, util.StrFromPtr(args.ParentID), args.CommentText))
 	if err != nil {
 		return errors.Prefix("could not authenticate channel signature:", err)
 	}
@@ -179,13 +198,11 @@ func applyModStatus(item *commentapi.CommentItem, channelID, claimID string) err
 	}
 	if signingChannel != nil {
 		item.IsCreator = channelID == signingChannel.ClaimID
-		filterCreator := m.DelegatedModeratorWhere.CreatorChannelID.EQ(signingChannel.ClaimID)
-		filterCommenter := m.DelegatedModeratorWhere.ModChannelID.EQ(channelID)
-		isMod, err := m.DelegatedModerators(filterCreator, filterCommenter).Exists(db.RO)
+		delegate, err := helper.ActiveDelegate(channelID, signingChannel.ClaimID)
 		if err != nil {
-			return errors.Err(err)
+			return err
 		}
-		item.IsModerator = isMod
+		item.IsModerator = delegate != nil && helper.InClaimScope(delegate, claimID)
 	}
 	return nil
 }
@@ -293,10 +310,11 @@ func blockedByCreator(request *createRequest) error {
 }
 
 func checkSettings(settings *m.CreatorSetting, request *createRequest) error {
-	isMod, err := m.DelegatedModerators(m.DelegatedModeratorWhere.ModChannelID.EQ(request.args.ChannelID), m.DelegatedModeratorWhere.CreatorChannelID.EQ(request.signingChannel.ClaimID)).Exists(db.RO)
+	delegate, err := helper.ActiveDelegate(request.args.ChannelID, request.signingChannel.ClaimID)
 	if err != nil {
-		return errors.Err(err)
+		return err
 	}
+	isMod := delegate != nil && helper.InClaimScope(delegate, request.args.ClaimID)
 	if !isMod && request.args.ChannelID != request.creatorChannel.ClaimID {
 		if !settings.MinTipAmountSuperChat.IsZero() && !request.comment.Amount.IsZero() && request.args.PaymentIntentID == nil {
 			if request.comment.Amount.Uint64 < settings.MinTipAmountSuperChat.Uint64 {
@@ -325,6 +343,10 @@ func checkSettings(settings *m.CreatorSetting, request *createRequest) error {
 				}
 			}
 		}
+		err = checkChatModes(settings, request)
+		if err != nil {
+			return err
+		}
 	}
 	if !settings.CommentsEnabled.Valid {
 		for _, tag := range request.signingChannel.Value.Tags {
@@ -401,6 +423,8 @@ func updateSupportInfoAttempt(request *createRequest) error {
 		request.comment.Amount.SetValid(uint64(pi.Amount))
 		request.comment.IsFiat = true
 		request.comment.Currency.SetValid(pi.Currency)
+		request.comment.PaymentIntentID.SetValid(pi.ID)
+		request.comment.PaymentStatus.SetValid(string(pi.Status))
 		return nil
 
 	}
@@ -421,6 +445,9 @@ func updateSupportInfoAttempt(request *createRequest) error {
 		return errors.Err(err)
 	}
 	request.comment.Amount.SetValid(amount)
+	request.comment.TXVout.SetValid(uint(vout))
+	request.comment.TXConfirmations.SetValid(uint(txSummary.Outputs[int(vout)].Confirmations))
+	request.comment.PaymentStatus.SetValid(commentapi.PaymentPending)
 	return nil
 }
 
@@ -30,7 +30,7 @@ func edit(args *commentapi.EditArgs) (*commentapi.CommentItem, error) {
 	if channel == nil {
 		return nil, api.StatusError{Err: errors.Err("channel id %s could not be found"), Status: http.StatusBadRequest}
 	}
-	err = lbry.ValidateSignature(comment.ChannelID.String, args.Signature, args.SigningTS, args.Comment)
+	err = lbry.ValidateSignature(comment.ChannelID.String, args.Signature, args.SigningTS, lbry.Call("comment.Edit", args.Comment, args.CommentID, args.Comment))
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +40,16 @@ func edit(args *commentapi.EditArgs) (*commentapi.CommentItem, error) {
 	comment.Signature.SetValid(args.Signature)
 	comment.Signingts.SetValid(args.SigningTS)
 	comment.Timestamp = int(time.Now().Unix())
-	err = comment.Update(db.RW, boil.Infer())
+	item := populateItem(comment, channel)
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := comment.Update(tx, boil.Infer())
+		if err != nil {
+			return errors.Err(err)
+		}
+		return queueNotifications(tx, actionEdit, comment, item)
+	})
 	if err != nil {
 		return nil, errors.Err(err)
 	}
-	item := populateItem(comment, channel, 0)
 	return &item, nil
 }
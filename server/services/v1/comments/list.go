@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"math"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/lbryio/commentron/commentapi"
@@ -22,6 +23,10 @@ import (
 
 func list(_ *http.Request, args *commentapi.ListArgs, reply *commentapi.ListResponse) error {
 	args.ApplyDefaults()
+	err := validateViewer(args)
+	if err != nil {
+		return err
+	}
 	creatorChannel, err := checkCommentsEnabled(null.StringFromPtr(args.ChannelName), null.StringFromPtr(args.ChannelID))
 	if err != nil {
 		return err
@@ -36,7 +41,7 @@ func list(_ *http.Request, args *commentapi.ListArgs, reply *commentapi.ListResp
 	totalFilteredCommentsQuery := make([]qm.QueryMod, 0)
 	totalCommentsQuery := make([]qm.QueryMod, 0)
 	offset := (args.Page - 1) * args.PageSize
-	getCommentsQuery := applySorting(args.SortBy, []qm.QueryMod{loadChannels, qm.Offset(offset), qm.Limit(args.PageSize)})
+	getCommentsQuery := applySorting(sortColumns(args.SortBy, args.CreatorLikedFirst), []qm.QueryMod{loadChannels, qm.Offset(offset), qm.Limit(args.PageSize)})
 	hasHiddenCommentsQuery := []qm.QueryMod{filterIsHidden, qm.Limit(1)}
 
 	if args.AuthorClaimID != nil {
@@ -87,6 +92,13 @@ func list(_ *http.Request, args *commentapi.ListArgs, reply *commentapi.ListResp
 	}
 
 	items, blockedCommentCnt, err := getItems(comments, creatorChannel)
+	if err != nil {
+		return err
+	}
+	err = applyReactions(items, args)
+	if err != nil {
+		return err
+	}
 
 	totalFilteredItems = totalFilteredItems - blockedCommentCnt
 	reply.Items = items
@@ -100,20 +112,66 @@ func list(_ *http.Request, args *commentapi.ListArgs, reply *commentapi.ListResp
 	return nil
 }
 
-func applySorting(sort commentapi.Sort, queryMods []qm.QueryMod) []qm.QueryMod {
-	if sort != commentapi.Newest {
-		if sort == commentapi.Popularity {
-			queryMods = append(queryMods, qm.OrderBy(m.CommentColumns.IsPinned+" DESC, "+m.CommentColumns.PopularityScore+" DESC, "+m.CommentColumns.Timestamp+" DESC"))
-		} else if sort == commentapi.Controversy {
-			queryMods = append(queryMods, qm.OrderBy(m.CommentColumns.IsPinned+" DESC, "+m.CommentColumns.ControversyScore+" DESC, "+m.CommentColumns.Timestamp+" DESC"))
-		} else if sort == commentapi.Oldest {
-			queryMods = append(queryMods, qm.OrderBy(m.CommentColumns.IsPinned+" DESC, "+m.CommentColumns.Timestamp+" ASC"))
+func applySorting(columns []sortColumn, queryMods []qm.QueryMod) []qm.QueryMod {
+	var orderBy []string
+	for _, c := range columns {
+		if c.desc {
+			orderBy = append(orderBy, c.name+" DESC")
+		} else {
+			orderBy = append(orderBy, c.name+" ASC")
 		}
-	} else {
-		queryMods = append(queryMods, qm.OrderBy(m.CommentColumns.IsPinned+" DESC, "+m.CommentColumns.Timestamp+" DESC"))
 	}
+	return append(queryMods, qm.OrderBy(strings.Join(orderBy, ", ")))
+}
+
+// sortColumn is a column comments are ordered by and how to get its value from a comment
+type sortColumn struct {
+	name  string
+	desc  bool
+	value func(c *m.Comment) interface{}
+}
+
+var (
+	pinnedColumn       = sortColumn{m.CommentColumns.IsPinned, true, func(c *m.Comment) interface{} { return c.IsPinned }}
+	creatorLikedColumn = sortColumn{"(" + m.CommentColumns.CreatorLikedBy + " IS NOT NULL)", true, func(c *m.Comment) interface{} { return c.CreatorLikedBy.Valid }}
+	popularityColumn   = sortColumn{m.CommentColumns.PopularityScore, true, func(c *m.Comment) interface{} { return nullInt(c.PopularityScore) }}
+	controversyColumn  = sortColumn{m.CommentColumns.ControversyScore, true, func(c *m.Comment) interface{} { return nullInt(c.ControversyScore) }}
+	hotColumn          = sortColumn{m.CommentColumns.HotScore, true, func(c *m.Comment) interface{} { return c.HotScore }}
+	bestColumn         = sortColumn{m.CommentColumns.BestScore, true, func(c *m.Comment) interface{} { return c.BestScore }}
+	newestColumn       = sortColumn{m.CommentColumns.Timestamp, true, func(c *m.Comment) interface{} { return c.Timestamp }}
+	oldestColumn       = sortColumn{m.CommentColumns.Timestamp, false, func(c *m.Comment) interface{} { return c.Timestamp }}
+	// tieBreakColumn keeps the order stable between pages for comments made in the same second
+	tieBreakColumn = sortColumn{m.CommentColumns.CommentID, false, func(c *m.Comment) interface{} { return c.CommentID }}
+)
+
+// sortColumns returns the columns of the sort, pinned comments always come first followed by the comments liked by
+// the creator if they should be
+func sortColumns(sort commentapi.Sort, creatorLikedFirst bool) []sortColumn {
+	columns := []sortColumn{pinnedColumn}
+	if creatorLikedFirst {
+		columns = append(columns, creatorLikedColumn)
+	}
+	switch sort {
+	case commentapi.Popularity:
+		return append(columns, popularityColumn, newestColumn, tieBreakColumn)
+	case commentapi.Controversy:
+		return append(columns, controversyColumn, newestColumn, tieBreakColumn)
+	case commentapi.Hot:
+		return append(columns, hotColumn, newestColumn, tieBreakColumn)
+	case commentapi.Best:
+		return append(columns, bestColumn, newestColumn, tieBreakColumn)
+	case commentapi.Oldest:
+		return append(columns, oldestColumn, tieBreakColumn)
+	default:
+		return append(columns, newestColumn, tieBreakColumn)
+	}
+}
 
-	return queryMods
+func nullInt(i null.Int) interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Int
 }
 
 func checkCommentsEnabled(channelName, ChannelID null.String) (*m.Channel, error) {
@@ -172,12 +230,8 @@ Comments:
 			channel = comment.R.Channel
 			if channel != nil && channel.Name != "" {
 				if !alreadyInSet[comment.CommentID] {
-					replies, err := comment.ParentComments().Count(db.RO)
-					if err != nil && errors.Is(err, sql.ErrNoRows) {
-						return items, blockedCommentCnt, errors.Err(err)
-					}
 					alreadyInSet[comment.CommentID] = true
-					items = append(items, populateItem(comment, channel, int(replies)))
+					items = append(items, populateItem(comment, channel))
 				}
 			}
 		}
@@ -0,0 +1,109 @@
+package comments
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+func locate(_ *http.Request, args *commentapi.LocateArgs, reply *commentapi.LocateResponse) error {
+	if err := args.Validate(); err.Err != nil {
+		return err
+	}
+	args.ApplyDefaults()
+	creatorChannel, err := checkCommentsEnabled(null.StringFromPtr(args.ChannelName), null.StringFromPtr(args.ChannelID))
+	if err != nil {
+		return err
+	}
+	loadChannels := qm.Load("Channel.BlockedChannelBlockedEntries")
+	comment, err := m.Comments(m.CommentWhere.CommentID.EQ(args.CommentID), loadChannels).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) {
+		return api.StatusError{Err: errors.Err("comment for id %s could not be found", args.CommentID), Status: http.StatusBadRequest}
+	}
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	thread := m.CommentSlice{comment}
+	for last := comment; last.ParentID.Valid; {
+		last, err = last.Parent(loadChannels).One(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		thread = append(thread, last)
+	}
+
+	// The comment can only be located if list would show it and every comment above it
+	items, _, err := getItems(thread, creatorChannel)
+	if err != nil {
+		return err
+	}
+	if len(items) != len(thread) {
+		return api.StatusError{Err: errors.Err("comment %s is not visible", args.CommentID), Status: http.StatusBadRequest}
+	}
+
+	topLevel := thread[len(thread)-1]
+	reply.TopLevelPage, err = pageOf(topLevel, args, m.CommentWhere.LbryClaimID.EQ(topLevel.LbryClaimID), m.CommentWhere.ParentID.IsNull())
+	if err != nil {
+		return err
+	}
+	if comment.ParentID.Valid {
+		reply.ReplyPage, err = pageOf(comment, args, m.CommentWhere.ParentID.EQ(comment.ParentID))
+		if err != nil {
+			return err
+		}
+		reply.ParentID = &comment.ParentID.String
+	}
+	reply.CommentID = comment.CommentID
+	reply.ClaimID = comment.LbryClaimID
+	reply.TopLevelCommentID = topLevel.CommentID
+	reply.PageSize = args.PageSize
+	reply.SortBy = args.SortBy
+	return nil
+}
+
+// pageOf returns the page the comment is on when listing the comments of the filters
+func pageOf(comment *m.Comment, args *commentapi.LocateArgs, filters ...qm.QueryMod) (int, error) {
+	filters = append(filters, precedes(sortColumns(args.SortBy, args.CreatorLikedFirst), comment))
+	before, err := m.Comments(filters...).Count(db.RO)
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	return int(before)/args.PageSize + 1, nil
+}
+
+// precedes filters to the comments that come before the comment when ordered by the sort columns. NULL values are
+// ordered as the lowest values, the same as MySQL does.
+func precedes(columns []sortColumn, comment *m.Comment) qm.QueryMod {
+	var clauses, equal []string
+	var args, equalArgs []interface{}
+	for _, c := range columns {
+		value := c.value(comment)
+		var clause string
+		var clauseArgs []interface{}
+		if c.desc && value == nil {
+			clause = c.name + " IS NOT NULL"
+		} else if c.desc {
+			clause, clauseArgs = c.name+" > ?", []interface{}{value}
+		} else if value != nil {
+			clause, clauseArgs = "("+c.name+" < ? OR "+c.name+" IS NULL)", []interface{}{value}
+		}
+		if clause != "" {
+			clauses = append(clauses, "("+strings.Join(append(equal[:len(equal):len(equal)], clause), " AND ")+")")
+			args = append(append(args, equalArgs...), clauseArgs...)
+		}
+		equal = append(equal, c.name+" <=> ?")
+		equalArgs = append(equalArgs, value)
+	}
+	return qm.Where("("+strings.Join(clauses, " OR ")+")", args...)
+}
@@ -0,0 +1,66 @@
+package comments
+
+import (
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/webhooks"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/lbryio/lbry.go/v2/extras/util"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Actions on comments as the internal-apis action types
+const (
+	actionCreate = "C"
+	actionEdit   = "U"
+	actionDelete = "D"
+)
+
+// queueNotifications queues the internal-apis notification, the webhook events and the change log entry of the
+// action on the comment, in the transaction of the action
+func queueNotifications(exec boil.Executor, actionType string, comment *m.Comment, item commentapi.CommentItem) error {
+	options := lbry.NotifyOptions{
+		ActionType: actionType,
+		CommentID:  item.CommentID,
+		ChannelID:  &item.ChannelID,
+		ParentID:   &item.ParentID,
+		Comment:    &item.Comment,
+		ClaimID:    item.ClaimID,
+	}
+	events := []string{commentapi.WebhookEdited}
+	change := commentapi.ChangeEdit
+	switch actionType {
+	case actionCreate:
+		// new comments also have the support they came with
+		amount, err := btcutil.NewAmount(item.SupportAmount)
+		if err != nil {
+			return errors.Err(err)
+		}
+		options.Amount = uint64(amount)
+		options.IsFiat = item.IsFiat
+		options.Currency = util.PtrToString(item.Currency)
+		events, change = []string{commentapi.WebhookCreated}, commentapi.ChangeCreate
+		if item.SupportAmount > 0 {
+			events = append(events, commentapi.WebhookHyperchat)
+		}
+	case actionDelete:
+		events, change = []string{commentapi.WebhookDeleted}, commentapi.ChangeDelete
+	}
+
+	err := lbry.QueueNotification(exec, options)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		err = webhooks.Publish(exec, event, comment.CreatorChannelID.String, &item)
+		if err != nil {
+			return err
+		}
+	}
+	return helper.RecordChange(exec, change, comment, comment.ChannelID.String, &item)
+}
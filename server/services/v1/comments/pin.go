@@ -3,9 +3,11 @@ package comments
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
 	"github.com/lbryio/commentron/server/lbry"
 	"github.com/lbryio/commentron/sockety"
@@ -52,17 +54,46 @@ func pin(_ *http.Request, args *commentapi.PinArgs) (commentapi.CommentItem, err
 		}
 	}
 
-	err = lbry.ValidateSignatureFromClaim(claimChannel, args.Signature, args.SigningTS, args.CommentID)
-	if err != nil {
-		return item, err
+	digest := lbry.Call("comment.Pin", args.CommentID, args.CommentID, strconv.FormatBool(args.Remove))
+	actorChannelID := claimChannel.ClaimID
+	if args.ModChannelID != "" {
+		modChannel, _, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, claimChannel.ClaimID, claimChannel.Name, comment.LbryClaimID, commentapi.PermissionPin)
+		if err != nil {
+			return item, err
+		}
+		err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, digest)
+		if err != nil {
+			return item, err
+		}
+		actorChannelID = modChannel.ClaimID
+	} else {
+		err = lbry.ValidateSignatureFromClaim(claimChannel, args.Signature, args.SigningTS, digest)
+		if err != nil {
+			return item, err
+		}
 	}
 	comment.IsPinned = !args.Remove
 	err = comment.Update(db.RW, boil.Infer())
 	if err != nil {
 		return item, errors.Err(err)
 	}
+	action := commentapi.ActionPin
+	if args.Remove {
+		action = commentapi.ActionUnpin
+	}
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           action,
+		ActorChannelID:   actorChannelID,
+		CreatorChannelID: claimChannel.ClaimID,
+		TargetChannelID:  comment.ChannelID.String,
+		ClaimID:          comment.LbryClaimID,
+		CommentID:        comment.CommentID,
+	})
+	if err != nil {
+		return item, err
+	}
 
-	item = populateItem(comment, channel, 0)
+	item = populateItem(comment, channel)
 	go sockety.SendNotification(socketyapi.SendNotificationArgs{
 		Service: socketyapi.Commentron,
 		Type:    "pinned",
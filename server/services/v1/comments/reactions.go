@@ -0,0 +1,72 @@
+package comments
+
+import (
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// validateViewer checks the viewer channel was signed by the viewer when their reactions are asked for
+func validateViewer(args *commentapi.ListArgs) error {
+	if args.ViewerChannelID == nil {
+		return nil
+	}
+	err := lbry.ValidateSignature(*args.ViewerChannelID, args.Signature, args.SigningTS, lbry.ReadOnly("comment.List", null.StringFromPtr(args.ViewerChannelName).String, *args.ViewerChannelID))
+	if err != nil {
+		return errors.Prefix("could not authenticate viewer channel signature:", err)
+	}
+	return nil
+}
+
+// applyReactions adds the reaction counts and the reactions of the viewer to the items as asked for in the list args,
+// with a query for each regardless of the number of comments
+func applyReactions(items []commentapi.CommentItem, args *commentapi.ListArgs) error {
+	if len(items) == 0 {
+		return nil
+	}
+	byID := make(map[string]*commentapi.CommentItem, len(items))
+	var commentIDs []interface{}
+	for i := range items {
+		byID[items[i].CommentID] = &items[i]
+		commentIDs = append(commentIDs, items[i].CommentID)
+	}
+
+	if args.IncludeReactions {
+		counts, err := m.CommentReactionCounts(
+			qm.WhereIn(m.CommentReactionCountColumns.CommentID+" IN ?", commentIDs...),
+			qm.Load(m.CommentReactionCountRels.ReactionType)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, item := range byID {
+			item.Reactions = make(commentapi.CommentReaction)
+		}
+		for _, c := range counts {
+			byID[c.CommentID].Reactions[c.R.ReactionType.Name] = c.Count
+		}
+	}
+
+	if args.ViewerChannelID != nil {
+		reactions, err := m.Reactions(
+			m.ReactionWhere.ChannelID.EQ(null.StringFrom(*args.ViewerChannelID)),
+			qm.WhereIn(m.ReactionColumns.CommentID+" IN ?", commentIDs...),
+			qm.Load(m.ReactionRels.ReactionType)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, item := range byID {
+			item.MyReactions = make(commentapi.CommentReaction)
+		}
+		for _, r := range reactions {
+			byID[r.CommentID].MyReactions[r.R.ReactionType.Name]++
+		}
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
 	m "github.com/lbryio/commentron/model"
-	"github.com/lbryio/commentron/server/lbry"
 
 	"github.com/lbryio/lbry.go/extras/api"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
@@ -25,7 +24,7 @@ NEW APIS
 comment count per claim
 comment count per parent
 comments per parent ( order-by time, rating, page, page-size )
-page for comment ( params: page, [size], order-by )
+DONE page for comment ( params: page, [size], order-by )
 
 */
 
@@ -59,6 +58,11 @@ func (c *Service) GetChannelFromCommentID(_ *http.Request, args *commentapi.Chan
 	return nil
 }
 
+// Changes returns the change log of comments, reactions and blocks after a seq, for indexers to stay in sync with
+func (c *Service) Changes(r *http.Request, args *commentapi.ChangesArgs, reply *commentapi.ChangesResponse) error {
+	return changes(r, args, reply)
+}
+
 // Abandon deletes a comment
 func (c *Service) Abandon(_ *http.Request, args *commentapi.AbandonArgs, reply *commentapi.AbandonResponse) error {
 	item, err := abandon(args)
@@ -68,15 +72,6 @@ func (c *Service) Abandon(_ *http.Request, args *commentapi.AbandonArgs, reply *
 	reply.CommentItem = item
 	reply.Abandoned = true
 
-	go lbry.API.Notify(lbry.NotifyOptions{
-		ActionType: "D",
-		CommentID:  item.CommentID,
-		ChannelID:  &item.ChannelID,
-		ParentID:   &item.ParentID,
-		Comment:    &item.Comment,
-		ClaimID:    item.ClaimID,
-	})
-
 	return nil
 }
 
@@ -87,15 +82,6 @@ func (c *Service) Edit(_ *http.Request, args *commentapi.EditArgs, reply *commen
 		return errors.Err(err)
 	}
 	reply.CommentItem = item
-
-	go lbry.API.Notify(lbry.NotifyOptions{
-		ActionType: "U",
-		CommentID:  item.CommentID,
-		ChannelID:  &item.ChannelID,
-		ParentID:   &item.ParentID,
-		Comment:    &item.Comment,
-		ClaimID:    item.ClaimID,
-	})
 	return nil
 }
 
@@ -124,3 +110,18 @@ func (c *Service) Pin(r *http.Request, args *commentapi.PinArgs, reply *commenta
 func (c *Service) SuperChatList(r *http.Request, args *commentapi.SuperListArgs, reply *commentapi.SuperListResponse) error {
 	return superChatList(r, args, reply)
 }
+
+// SuperChatTicker returns the hyperchats of a claim that are still on the ticker
+func (c *Service) SuperChatTicker(r *http.Request, args *commentapi.SuperChatTickerArgs, reply *commentapi.SuperChatTickerResponse) error {
+	return superChatTicker(r, args, reply)
+}
+
+// Locate finds the page a comment is on and the page of its thread under the sort, used for linking to a comment
+func (c *Service) Locate(r *http.Request, args *commentapi.LocateArgs, reply *commentapi.LocateResponse) error {
+	return locate(r, args, reply)
+}
+
+// TopSupporters ranks the channels that have given the most in hyperchats to a claim or creator
+func (c *Service) TopSupporters(r *http.Request, args *commentapi.TopSupportersArgs, reply *commentapi.TopSupportersResponse) error {
+	return topSupporters(r, args, reply)
+}
@@ -3,20 +3,87 @@ package comments
 import (
 	"math"
 	"net/http"
+	"strings"
 
 	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/config"
 	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
 	m "github.com/lbryio/commentron/model"
 	"github.com/lbryio/commentron/server/lbry"
 
 	"github.com/lbryio/lbry.go/extras/util"
+	"github.com/lbryio/lbry.go/v2/extras/api"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
-	"github.com/btcsuite/btcutil"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
+// minAmountFilter filters out hyperchats below the amount in their own currency
+func minAmountFilter(minAmount float64) (qm.QueryMod, error) {
+	minLBC, err := helper.ToBaseUnits(helper.LBC, minAmount)
+	if err != nil {
+		return nil, api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	// Fiat amounts are stored in cents, except for the zero decimal currencies stored in whole units
+	minFiat, err := helper.ToBaseUnits("USD", minAmount)
+	if err != nil {
+		return nil, api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	minZeroDecimal, err := helper.ToBaseUnits(helper.ZeroDecimalCurrencies[0], minAmount)
+	if err != nil {
+		return nil, api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	zeroDecimal := strings.TrimSuffix(strings.Repeat("?,", len(helper.ZeroDecimalCurrencies)), ",")
+	args := []interface{}{false, minLBC, true, minFiat}
+	for _, c := range helper.ZeroDecimalCurrencies {
+		args = append(args, c)
+	}
+	args = append(args, true, minZeroDecimal)
+	for _, c := range helper.ZeroDecimalCurrencies {
+		args = append(args, c)
+	}
+	return qm.Where("(("+m.CommentColumns.IsFiat+" = ? AND "+m.CommentColumns.Amount+" >= ?) OR "+
+		"("+m.CommentColumns.IsFiat+" = ? AND "+m.CommentColumns.Amount+" >= ? AND COALESCE("+m.CommentColumns.Currency+", '') NOT IN ("+zeroDecimal+")) OR "+
+		"("+m.CommentColumns.IsFiat+" = ? AND "+m.CommentColumns.Amount+" >= ? AND "+m.CommentColumns.Currency+" IN ("+zeroDecimal+")))",
+		args...), nil
+}
+
+// superChatTotals sums up the hyperchats for each currency
+func superChatTotals(query []qm.QueryMod) (map[string]float64, error) {
+	rows, err := m.Comments(query...).Query.Query(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	defer helper.CloseRows(rows)
+	totals := make(map[string]float64)
+	for rows.Next() {
+		var isFiat bool
+		var currency null.String
+		var amount null.Uint64
+		err = rows.Scan(&isFiat, &currency, &amount)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		c := helper.TickerCurrency(&m.Comment{IsFiat: isFiat, Currency: currency})
+		totals[c] += helper.FromBaseUnits(c, amount.Uint64)
+	}
+	return totals, errors.Err(rows.Err())
+}
+
+// approxUSDTotal converts the totals to USD skipping currencies without a configured exchange rate
+func approxUSDTotal(totals map[string]float64) float64 {
+	var total float64
+	for currency, amount := range totals {
+		rate, ok := config.USDRate(currency)
+		if ok {
+			total += amount * rate
+		}
+	}
+	return math.Round(total*100) / 100
+}
+
 func superChatList(_ *http.Request, args *commentapi.SuperListArgs, reply *commentapi.SuperListResponse) error {
 	args.ApplyDefaults()
 	loadChannels := qm.Load("Channel.BlockedChannelBlockedEntries")
@@ -28,7 +95,9 @@ func superChatList(_ *http.Request, args *commentapi.SuperListArgs, reply *comme
 	filterSuperChats := m.CommentWhere.Amount.GTE(null.Uint64From(uint64(args.SuperChatsAmount)))
 
 	totalCommentsQuery := make([]qm.QueryMod, 0)
-	totalSuperChatAmountQuery := []qm.QueryMod{qm.Select(`SUM(` + m.CommentColumns.Amount + `)`)}
+	totalSuperChatAmountQuery := []qm.QueryMod{
+		qm.Select(m.CommentColumns.IsFiat, m.CommentColumns.Currency, `CAST(SUM(`+m.CommentColumns.Amount+`) AS UNSIGNED)`),
+		qm.GroupBy(m.CommentColumns.IsFiat + ", " + m.CommentColumns.Currency)}
 	offset := (args.Page - 1) * args.PageSize
 	getCommentsQuery := []qm.QueryMod{loadChannels, qm.Offset(offset), qm.Limit(args.PageSize), qm.OrderBy(m.CommentColumns.IsFiat + " DESC, " + m.CommentColumns.Amount + " DESC, " + m.CommentColumns.Timestamp + " DESC")}
 	hasHiddenCommentsQuery := []qm.QueryMod{filterIsHidden, qm.Limit(1)}
@@ -67,11 +136,20 @@ func superChatList(_ *http.Request, args *commentapi.SuperListArgs, reply *comme
 		totalCommentsQuery = append(totalCommentsQuery, filterSuperChats)
 		totalSuperChatAmountQuery = append(totalSuperChatAmountQuery, filterSuperChats)
 	}
-	var superChatAmount null.Uint64
-	result := m.Comments(totalSuperChatAmountQuery...).QueryRow(db.RO)
-	err := result.Scan(&superChatAmount)
+	if args.MinAmount != nil {
+		filterMinAmount, err := minAmountFilter(*args.MinAmount)
+		if err != nil {
+			return err
+		}
+		getCommentsQuery = append(getCommentsQuery, filterMinAmount)
+		hasHiddenCommentsQuery = append(hasHiddenCommentsQuery, filterMinAmount)
+		totalCommentsQuery = append(totalCommentsQuery, filterMinAmount)
+		totalSuperChatAmountQuery = append(totalSuperChatAmountQuery, filterMinAmount)
+	}
+
+	totals, err := superChatTotals(totalSuperChatAmountQuery)
 	if err != nil {
-		return errors.Err(err)
+		return err
 	}
 
 	totalItems, err := m.Comments(totalCommentsQuery...).Count(db.RO)
@@ -110,7 +188,9 @@ func superChatList(_ *http.Request, args *commentapi.SuperListArgs, reply *comme
 	reply.TotalItems = totalItems
 	reply.TotalPages = int(math.Ceil(float64(totalItems) / float64(args.PageSize)))
 	reply.HasHiddenComments = hasHiddenComments
-	reply.TotalAmount = btcutil.Amount(superChatAmount.Uint64).ToBTC()
+	reply.TotalAmount = totals[helper.LBC]
+	reply.TotalAmounts = totals
+	reply.ApproxUSDTotal = approxUSDTotal(totals)
 
 	return nil
 }
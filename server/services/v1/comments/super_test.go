@@ -0,0 +1,33 @@
+package comments
+
+import (
+	"testing"
+
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+func TestMinAmountFilter(t *testing.T) {
+	filter, err := minAmountFilter(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, args := queries.BuildQuery(m.Comments(filter).Query)
+	zeroDecimal := len(helper.ZeroDecimalCurrencies)
+	if len(args) != 6+2*zeroDecimal {
+		t.Fatalf("expected %d args got %d", 6+2*zeroDecimal, len(args))
+	}
+	expected := map[int]uint64{1: 500000000, 3: 500, 5 + zeroDecimal: 5}
+	for i, amount := range expected {
+		if args[i] != amount {
+			t.Errorf("arg %d: expected min amount %d got %v", i, amount, args[i])
+		}
+	}
+
+	_, err = minAmountFilter(-1)
+	if err == nil {
+		t.Error("expected an error for a negative min amount")
+	}
+}
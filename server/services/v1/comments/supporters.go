@@ -0,0 +1,191 @@
+package comments
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+type supporterRow struct {
+	ChannelID      string  `boil:"channel_id"`
+	ApproxUSD      float64 `boil:"approx_usd"`
+	LBCTotal       uint64  `boil:"lbc_total"`
+	HyperchatCount int64   `boil:"hyperchat_count"`
+}
+
+func topSupporters(_ *http.Request, args *commentapi.TopSupportersArgs, reply *commentapi.TopSupportersResponse) error {
+	if err := args.Validate(); err.Err != nil {
+		return err
+	}
+	args.ApplyDefaults()
+
+	creatorChannelID := args.CreatorChannelID
+	filters := []qm.QueryMod{
+		m.CommentWhere.Amount.GT(null.Uint64From(0)),
+		m.CommentWhere.ChannelID.IsNotNull(),
+		m.CommentWhere.IsFlagged.EQ(false),
+	}
+	if args.ClaimID != nil {
+		filters = append(filters, m.CommentWhere.LbryClaimID.EQ(*args.ClaimID))
+		if creatorChannelID == nil {
+			signingChannel, err := lbry.SDK.GetSigningChannelForClaim(*args.ClaimID)
+			if err != nil {
+				return errors.Err(err)
+			}
+			if signingChannel != nil {
+				creatorChannelID = &signingChannel.ClaimID
+			}
+		}
+	}
+	if args.CreatorChannelID != nil {
+		filters = append(filters, m.CommentWhere.CreatorChannelID.EQ(null.StringFromPtr(args.CreatorChannelID)))
+	}
+	if args.Window > 0 {
+		since := time.Now().Add(-time.Duration(args.Window) * time.Second)
+		filters = append(filters, m.CommentWhere.Timestamp.GTE(int(since.Unix())))
+	}
+	filters = append(filters, notBlockedFilter(creatorChannelID))
+
+	var totalItems int64
+	err := m.Comments(append(filters, qm.Select("COUNT(DISTINCT "+m.CommentColumns.ChannelID+")"))...).QueryRow(db.RO).Scan(&totalItems)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	var rows []supporterRow
+	offset := (args.Page - 1) * args.PageSize
+	err = m.Comments(append(filters,
+		qm.Select(m.CommentColumns.ChannelID,
+			approxUSDExpression()+" AS approx_usd",
+			"CAST(SUM(CASE WHEN "+m.CommentColumns.IsFiat+" = false THEN "+m.CommentColumns.Amount+" ELSE 0 END) AS UNSIGNED) AS lbc_total",
+			"COUNT(*) AS hyperchat_count"),
+		qm.GroupBy(m.CommentColumns.ChannelID),
+		qm.OrderBy("approx_usd DESC, lbc_total DESC, hyperchat_count DESC, "+m.CommentColumns.ChannelID),
+		qm.Offset(offset),
+		qm.Limit(args.PageSize))...).Bind(nil, db.RO, &rows)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	items, err := populateSupporters(rows, filters, offset)
+	if err != nil {
+		return err
+	}
+
+	reply.Items = items
+	reply.Page = args.Page
+	reply.PageSize = args.PageSize
+	reply.TotalItems = totalItems
+	reply.TotalPages = int(math.Ceil(float64(totalItems) / float64(args.PageSize)))
+	return nil
+}
+
+// notBlockedFilter excludes commenters that are universally blocked or blocked by the creator
+func notBlockedFilter(creatorChannelID *string) qm.QueryMod {
+	blocked := fmt.Sprintf("SELECT 1 FROM %s WHERE %s.%s = %s.%s AND (%s.%s IS NULL OR %s.%s > NOW()) AND (%s.%s = true",
+		m.TableNames.BlockedEntry,
+		m.TableNames.BlockedEntry, m.BlockedEntryColumns.BlockedChannelID, m.TableNames.Comment, m.CommentColumns.ChannelID,
+		m.TableNames.BlockedEntry, m.BlockedEntryColumns.Expiry, m.TableNames.BlockedEntry, m.BlockedEntryColumns.Expiry,
+		m.TableNames.BlockedEntry, m.BlockedEntryColumns.UniversallyBlocked)
+	if creatorChannelID == nil {
+		return qm.Where("NOT EXISTS (" + blocked + "))")
+	}
+	return qm.Where("NOT EXISTS ("+blocked+" OR "+m.TableNames.BlockedEntry+"."+m.BlockedEntryColumns.CreatorChannelID+" = ?))", *creatorChannelID)
+}
+
+// approxUSDExpression sums up the hyperchats in USD using the configured exchange rates. The rates are validated when
+// parsed so they are safe to inline.
+func approxUSDExpression() string {
+	var cases []string
+	for currency, rate := range config.ExchangeRates() {
+		r := strconv.FormatFloat(rate, 'f', -1, 64)
+		if currency == helper.LBC {
+			cases = append(cases, fmt.Sprintf("WHEN %s = false THEN %s * %s / %d", m.CommentColumns.IsFiat, m.CommentColumns.Amount, r, 100000000))
+			continue
+		}
+		cases = append(cases, fmt.Sprintf("WHEN %s = true AND UPPER(%s) = '%s' THEN %s * %s / 100", m.CommentColumns.IsFiat, m.CommentColumns.Currency, currency, m.CommentColumns.Amount, r))
+	}
+	if len(cases) == 0 {
+		return "0"
+	}
+	return "SUM(CASE " + strings.Join(cases, " ") + " ELSE 0 END)"
+}
+
+func populateSupporters(rows []supporterRow, filters []qm.QueryMod, offset int) ([]commentapi.Supporter, error) {
+	items := make([]commentapi.Supporter, 0, len(rows))
+	if len(rows) == 0 {
+		return items, nil
+	}
+	channelIDs := make([]interface{}, len(rows))
+	for i, r := range rows {
+		channelIDs[i] = r.ChannelID
+	}
+
+	channels, err := m.Channels(qm.WhereIn(m.ChannelColumns.ClaimID+" IN ?", channelIDs...)).All(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	names := make(map[string]string, len(channels))
+	for _, c := range channels {
+		names[c.ClaimID] = c.Name
+	}
+
+	breakdown, err := m.Comments(append(filters,
+		qm.Select(m.CommentColumns.ChannelID, m.CommentColumns.IsFiat, m.CommentColumns.Currency, "CAST(SUM("+m.CommentColumns.Amount+") AS UNSIGNED)"),
+		qm.WhereIn(m.CommentColumns.ChannelID+" IN ?", channelIDs...),
+		qm.GroupBy(m.CommentColumns.ChannelID+", "+m.CommentColumns.IsFiat+", "+m.CommentColumns.Currency))...).Query.Query(db.RO)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	defer helper.CloseRows(breakdown)
+	totals := make(map[string]map[string]float64, len(rows))
+	for breakdown.Next() {
+		var channelID string
+		var isFiat bool
+		var currency null.String
+		var amount null.Uint64
+		err = breakdown.Scan(&channelID, &isFiat, &currency, &amount)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		if totals[channelID] == nil {
+			totals[channelID] = make(map[string]float64)
+		}
+		c := helper.TickerCurrency(&m.Comment{IsFiat: isFiat, Currency: currency})
+		totals[channelID][c] += helper.FromBaseUnits(c, amount.Uint64)
+	}
+	if err := breakdown.Err(); err != nil {
+		return nil, errors.Err(err)
+	}
+
+	for i, r := range rows {
+		supporter := commentapi.Supporter{
+			Rank:           offset + i + 1,
+			ChannelID:      r.ChannelID,
+			ChannelName:    names[r.ChannelID],
+			Totals:         totals[r.ChannelID],
+			HyperchatCount: r.HyperchatCount,
+			ApproxUSDTotal: approxUSDTotal(totals[r.ChannelID]),
+		}
+		if supporter.ChannelName != "" {
+			supporter.ChannelURL = fmt.Sprintf("lbry://%s#%s", supporter.ChannelName, r.ChannelID)
+		}
+		items = append(items, supporter)
+	}
+	return items, nil
+}
@@ -0,0 +1,97 @@
+package comments
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/websocket"
+	"github.com/lbryio/commentron/sockety"
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/lbryio/sockety/socketyapi"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// maxTickerItems is the most hyperchats returned for a ticker
+const maxTickerItems = 50
+
+// applyTickerExpiry sets how long a hyperchat stays on the ticker based on the creator's tiers
+func applyTickerExpiry(request *createRequest) error {
+	if request.comment.Amount.IsZero() || request.creatorChannel == nil {
+		return nil
+	}
+	tiers, err := helper.GetTickerTiers(request.creatorChannel.ClaimID)
+	if err != nil {
+		return err
+	}
+	duration := helper.TickerDuration(tiers, helper.TickerCurrency(request.comment), request.comment.Amount.Uint64)
+	if duration > 0 {
+		request.comment.PinnedUntil.SetValid(uint64(request.comment.Timestamp) + uint64(duration.Seconds()))
+	}
+	return nil
+}
+
+func pushTickerStart(item commentapi.CommentItem, claimID string) {
+	websocket.PushTo(&websocket.PushNotification{
+		Type: "ticker_start",
+		Data: map[string]interface{}{"comment": item},
+	}, claimID)
+
+	go sockety.SendNotification(socketyapi.SendNotificationArgs{
+		Service: socketyapi.Commentron,
+		Type:    "ticker_start",
+		IDs:     []string{claimID, "comments"},
+		Data:    map[string]interface{}{"comment": item},
+	})
+}
+
+func superChatTicker(_ *http.Request, args *commentapi.SuperChatTickerArgs, reply *commentapi.SuperChatTickerResponse) error {
+	err := v.ValidateStruct(args, v.Field(&args.ClaimID, validator.ClaimID, v.Required))
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	comments, err := m.Comments(
+		qm.Load("Channel.BlockedChannelBlockedEntries"),
+		m.CommentWhere.LbryClaimID.EQ(args.ClaimID),
+		m.CommentWhere.PinnedUntil.GT(null.Uint64From(uint64(time.Now().Unix()))),
+		m.CommentWhere.IsFlagged.EQ(false),
+		qm.Where("("+m.CommentColumns.IsHidden+" IS NULL OR "+m.CommentColumns.IsHidden+" = ?)", false),
+		qm.OrderBy(m.CommentColumns.Timestamp+" DESC"),
+		qm.Limit(maxTickerItems)).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	channelClaim, err := lbry.SDK.GetSigningChannelForClaim(args.ClaimID)
+	if err != nil {
+		return errors.Err(err)
+	}
+	var creatorChannel *m.Channel
+	if channelClaim != nil {
+		creatorChannel, err = m.Channels(m.ChannelWhere.ClaimID.EQ(channelClaim.ClaimID)).One(db.RO)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Err(err)
+		}
+	}
+
+	items, _, err := getItems(comments, creatorChannel)
+	if err != nil {
+		return err
+	}
+	reply.Items = items
+	if reply.Items == nil {
+		reply.Items = []commentapi.CommentItem{}
+	}
+	return nil
+}
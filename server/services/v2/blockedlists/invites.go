@@ -3,6 +3,7 @@ package blockedlists
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
@@ -18,7 +19,8 @@ import (
 )
 
 func invite(_ *http.Request, args *commentapi.SharedBlockedListInviteArgs, reply *commentapi.SharedBlockedListInviteResponse) error {
-	err := lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, args.ChannelName)
+	_, inviter, err := lbry.ValidateDelegation(args.ChannelID, args.ChannelName, args.ModChannelID, args.ModChannelName, commentapi.PermissionInvite,
+		args.Signature, args.SigningTS, lbry.Call("blockedlist.Invite", args.ChannelName, strconv.FormatUint(args.SharedBlockedListID, 10), args.InviteeChannelID))
 	if err != nil {
 		return err
 	}
@@ -30,10 +32,6 @@ func invite(_ *http.Request, args *commentapi.SharedBlockedListInviteArgs, reply
 		return errors.Err(err)
 	}
 
-	inviter, err := helper.FindOrCreateChannel(args.ChannelID, args.ChannelName)
-	if err != nil {
-		return errors.Err(err)
-	}
 	if (blockedList.MemberInviteEnabled.Valid && !blockedList.MemberInviteEnabled.Bool) && inviter.ClaimID != blockedList.ChannelID {
 		return api.StatusError{Err: errors.Err("shared blocked list %s does not have member inviting enabled", blockedList.Name)}
 	}
@@ -79,7 +77,8 @@ func invite(_ *http.Request, args *commentapi.SharedBlockedListInviteArgs, reply
 }
 
 func accept(_ *http.Request, args *commentapi.SharedBlockedListInviteAcceptArgs, _ *commentapi.SharedBlockedListInviteResponse) error {
-	err := lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, args.ChannelName)
+	err := lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, lbry.Call("blockedlist.Accept", args.ChannelName,
+		strconv.FormatUint(args.SharedBlockedListID, 10), strconv.FormatBool(args.Accepted)))
 	if err != nil {
 		return err
 	}
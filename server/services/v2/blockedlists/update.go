@@ -21,7 +21,11 @@ func update(_ *http.Request, args *commentapi.SharedBlockedListUpdateArgs, reply
 	if err != nil {
 		return errors.Err(err)
 	}
-	err = lbry.ValidateSignature(ownerChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	canonical, err := lbry.CanonicalArgs(args)
+	if err != nil {
+		return err
+	}
+	err = lbry.ValidateSignature(ownerChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call("blockedlist.Update", args.ChannelName, args.ChannelID, canonical))
 	if err != nil {
 		return err
 	}
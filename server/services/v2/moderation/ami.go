@@ -3,16 +3,18 @@ package moderation
 import (
 	"database/sql"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
 	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/moderators"
 
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
-	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
@@ -21,7 +23,7 @@ func amI(_ *http.Request, args *commentapi.AmIArgs, reply *commentapi.AmIRespons
 	if err != nil {
 		return errors.Err(err)
 	}
-	err = lbry.ValidateSignature(channel.ClaimID, args.Signature, args.SigningTS, channel.Name)
+	err = lbry.ValidateSignature(channel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("moderation.AmI", channel.Name, channel.ClaimID))
 	if err != nil {
 		return errors.Err(err)
 	}
@@ -30,22 +32,38 @@ func amI(_ *http.Request, args *commentapi.AmIArgs, reply *commentapi.AmIRespons
 		return errors.Err(err)
 	}
 	approvedChannels := make(map[string]string)
+	permissions := make(map[string]uint64)
+	invites := make(map[string]string)
+	claimScopes := make(map[string][]string)
 	for _, moderation := range moderations {
-		if moderation.R != nil && moderation.R.CreatorChannel != nil {
+		if moderation.R == nil || moderation.R.CreatorChannel == nil {
+			continue
+		}
+		if moderation.Status == commentapi.DelegateStatusPending && !inviteExpired(moderation) {
+			invites[moderation.R.CreatorChannel.Name] = moderation.R.CreatorChannel.ClaimID
+		} else if moderation.Status == commentapi.DelegateStatusActive && (!moderation.ExpiresAt.Valid || moderation.ExpiresAt.Time.After(time.Now())) {
 			reply.Type = "Channel"
 			approvedChannels[moderation.R.CreatorChannel.Name] = moderation.R.CreatorChannel.ClaimID
+			permissions[moderation.R.CreatorChannel.ClaimID] = moderation.Permissons
+			if moderation.ClaimScope.Valid {
+				claimScopes[moderation.R.CreatorChannel.ClaimID] = strings.Split(moderation.ClaimScope.String, ",")
+			}
 		}
 	}
 	reply.ChannelName = args.ChannelName
 	reply.ChannelID = args.ChannelID
 	reply.AuthorizedChannels = approvedChannels
+	reply.Permissions = permissions
+	reply.Invites = invites
+	reply.ClaimScopes = claimScopes
 
-	moderator, err := model.Moderators(model.ModeratorWhere.ModChannelID.EQ(null.StringFrom(args.ChannelID)), model.ModeratorWhere.ModLevel.EQ(1)).One(db.RO)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return errors.Err(err)
+	modLevel, err := moderators.Level(channel.ClaimID)
+	if err != nil {
+		return err
 	}
-	if moderator != nil {
+	if modLevel > 0 {
 		reply.Type = "Global"
+		reply.ModLevel = modLevel
 	}
 	return nil
 }
@@ -0,0 +1,108 @@
+package moderation
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+func auditLog(_ *http.Request, args *commentapi.AuditLogArgs, reply *commentapi.AuditLogResponse) error {
+	args.ApplyDefaults()
+	modChannel, err := helper.FindOrCreateChannel(args.ModChannelID, args.ModChannelName)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("moderation.AuditLog", "", args.CreatorChannelID))
+	if err != nil {
+		return err
+	}
+	isMod, err := modChannel.ModChannelModerators().Exists(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	var filters []qm.QueryMod
+	if isMod {
+		if args.CreatorChannelID != "" {
+			filters = append(filters, model.ModerationActionWhere.CreatorChannelID.EQ(null.StringFrom(args.CreatorChannelID)))
+		}
+	} else {
+		if args.CreatorChannelID != "" && args.CreatorChannelID != modChannel.ClaimID {
+			return api.StatusError{Err: errors.Err("only global moderators can see the audit log of other creators"), Status: http.StatusForbidden}
+		}
+		filters = append(filters, model.ModerationActionWhere.CreatorChannelID.EQ(null.StringFrom(modChannel.ClaimID)))
+	}
+	if args.ActorChannelID != nil {
+		filters = append(filters, model.ModerationActionWhere.ActorChannelID.EQ(*args.ActorChannelID))
+	}
+	if args.Action != nil {
+		filters = append(filters, model.ModerationActionWhere.Action.EQ(*args.Action))
+	}
+
+	totalItems, err := model.ModerationActions(filters...).Count(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	actions, err := model.ModerationActions(append(filters,
+		qm.OrderBy(model.ModerationActionColumns.ID+" DESC"),
+		qm.Offset((args.Page-1)*args.PageSize),
+		qm.Limit(args.PageSize))...).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	var actorIDs []interface{}
+	for _, a := range actions {
+		actorIDs = append(actorIDs, a.ActorChannelID)
+	}
+	actorNames := make(map[string]string)
+	if len(actorIDs) > 0 {
+		actors, err := model.Channels(qm.WhereIn(model.ChannelColumns.ClaimID+" IN ?", actorIDs...)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, c := range actors {
+			actorNames[c.ClaimID] = c.Name
+		}
+	}
+
+	reply.Actions = make([]commentapi.ModerationAction, len(actions))
+	for i, a := range actions {
+		reply.Actions[i] = commentapi.ModerationAction{
+			ID:               a.ID,
+			Action:           a.Action,
+			ActorChannelID:   a.ActorChannelID,
+			ActorChannelName: actorNames[a.ActorChannelID],
+			CreatorChannelID: a.CreatorChannelID.String,
+			TargetChannelID:  a.TargetChannelID.String,
+			ClaimID:          a.ClaimID.String,
+			CommentID:        a.CommentID.String,
+			Reason:           a.Reason.String,
+			APIKey:           a.APIKey.String,
+			CreatedAt:        a.CreatedAt,
+		}
+		if a.BeforeJSON.Valid {
+			reply.Actions[i].Before = json.RawMessage(a.BeforeJSON.String)
+		}
+		if a.AfterJSON.Valid {
+			reply.Actions[i].After = json.RawMessage(a.AfterJSON.String)
+		}
+	}
+	reply.Page = args.Page
+	reply.PageSize = args.PageSize
+	reply.TotalItems = totalItems
+	reply.TotalPages = int(math.Ceil(float64(totalItems) / float64(args.PageSize)))
+	return nil
+}
@@ -3,13 +3,18 @@ package moderation
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
+	"github.com/lbryio/commentron/server/apikeys"
 	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/moderators"
+	"github.com/lbryio/commentron/server/webhooks"
 	"github.com/lbryio/commentron/validator"
 
 	"github.com/lbryio/lbry.go/extras/api"
@@ -21,100 +26,196 @@ import (
 	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
-func block(_ *http.Request, args *commentapi.BlockArgs, reply *commentapi.BlockResponse) error {
+func block(r *http.Request, args *commentapi.BlockArgs, reply *commentapi.BlockResponse) error {
 	err := v.ValidateStruct(args,
 		v.Field(&args.BlockedChannelID, validator.ClaimID, v.Required),
 		v.Field(&args.BlockedChannelName, v.Required),
 		v.Field(&args.ModChannelID, validator.ClaimID, v.Required),
 		v.Field(&args.ModChannelName, v.Required),
+		v.Field(&args.ClaimID, validator.ClaimID),
 	)
 	if err != nil {
 		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
 	}
-	modChannel, creatorChannel, err := getModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName)
+	// blocks that expire only need PermissionTimeout, which PermissionBlock includes
+	permissions := commentapi.PermissionBlock
+	if args.TimeOut > 0 {
+		permissions = commentapi.PermissionTimeout
+	}
+	if args.DeleteAll {
+		permissions |= commentapi.PermissionDelete
+	}
+	// blocks cover all the claims of the creator, so delegates scoped to some claims cannot block
+	modChannel, creatorChannel, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, "", permissions)
+	if err != nil {
+		return err
+	}
+	digest := lbry.Call("moderation.Block", args.ModChannelName,
+		args.BlockedChannelID, args.CreatorChannelID, strconv.FormatBool(args.BlockAll), strconv.FormatUint(args.TimeOut, 10), strconv.FormatBool(args.DeleteAll))
+	if args.ClaimID != "" {
+		digest.Fields = append(digest.Fields, args.ClaimID)
+	}
+	err = apikeys.ValidateSignature(r, apikeys.ScopeModeration, modChannel.ClaimID, args.Signature, args.SigningTS, digest)
 	if err != nil {
 		return err
 	}
-	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, args.ModChannelName)
+
+	modLevel, err := moderators.Level(modChannel.ClaimID)
 	if err != nil {
 		return err
 	}
+	if args.BlockAll && modLevel < commentapi.ModLevelBlock {
+		return api.StatusError{Err: errors.Err("cannot block universally without admin privileges"), Status: http.StatusForbidden}
+	}
+	if args.DeleteAll && modLevel < commentapi.ModLevelDeleteAll {
+		return api.StatusError{Err: errors.Err("cannot delete all comments of user without global moderator level %d", commentapi.ModLevelDeleteAll), Status: http.StatusForbidden}
+	}
 
 	// Only get the block list they were invited to.
 	participatingBlockedList, err := creatorChannel.BlockedListInvite().One(db.RO)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return errors.Err(err)
 	}
+	if participatingBlockedList != nil && args.TimeOut > 0 {
+		return api.StatusError{Err: errors.Err("the block list rules you are participating have their time out hours settings per strike. You must stop participating in the shared blocked list to customize timeouts"), Status: http.StatusBadRequest}
+	}
 
 	bannedChannel, err := helper.FindOrCreateChannel(args.BlockedChannelID, args.BlockedChannelName)
 	if err != nil {
 		return errors.Err(err)
 	}
-	blockedEntry, err := model.BlockedEntries(
-		model.BlockedEntryWhere.BlockedChannelID.EQ(null.StringFrom(args.BlockedChannelID)),
-		model.BlockedEntryWhere.CreatorChannelID.EQ(null.StringFrom(creatorChannel.ClaimID))).One(db.RO)
-	if err != nil && err != sql.ErrNoRows {
-		return errors.Err(err)
-	}
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		blockedEntry, err := model.BlockedEntries(
+			model.BlockedEntryWhere.BlockedChannelID.EQ(null.StringFrom(args.BlockedChannelID)),
+			model.BlockedEntryWhere.CreatorChannelID.EQ(null.StringFrom(creatorChannel.ClaimID)),
+			qm.For("UPDATE")).One(tx)
+		if err != nil && err != sql.ErrNoRows {
+			return errors.Err(err)
+		}
 
-	if blockedEntry == nil {
-		blocklistID := null.Uint64{}
+		if blockedEntry != nil && !blockedEntry.Expiry.Valid && args.TimeOut > 0 {
+			// a timeout would lift the permanent block when it expires, which needs PermissionBlock
+			_, _, err = helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, "", commentapi.PermissionBlock)
+			if err != nil {
+				return err
+			}
+		}
+
+		var before interface{}
+		if blockedEntry != nil {
+			before = blockEvent(blockedEntry, bannedChannel, modChannel)
+		}
+		if blockedEntry == nil {
+			blocklistID := null.Uint64{}
+			if participatingBlockedList != nil {
+				blocklistID.SetValid(participatingBlockedList.ID)
+			}
+			blockedEntry = &model.BlockedEntry{
+				BlockedChannelID: null.StringFrom(bannedChannel.ClaimID),
+				CreatorChannelID: null.StringFrom(creatorChannel.ClaimID),
+				BlockedListID:    blocklistID,
+			}
+			err := blockedEntry.Insert(tx, boil.Infer())
+			if err != nil {
+				return errors.Err(err)
+			}
+		} else {
+			blockedEntry.Strikes.SetValid(blockedEntry.Strikes.Int + 1)
+		}
 		if participatingBlockedList != nil {
-			blocklistID.SetValid(participatingBlockedList.ID)
+			blockedEntry.Expiry.SetValid(time.Now().Add(getStrikeDuration(blockedEntry.Strikes.Int, participatingBlockedList)))
+		} else if args.TimeOut > 0 {
+			blockedEntry.Expiry.SetValid(time.Now().Add(time.Duration(args.TimeOut) * time.Second))
+		}
+		if args.BlockAll {
+			blockedEntry.CreatorChannelID.SetValid(creatorChannel.ClaimID)
+			blockedEntry.UniversallyBlocked.SetValid(true)
 		}
-		blockedEntry = &model.BlockedEntry{
-			BlockedChannelID: null.StringFrom(bannedChannel.ClaimID),
-			CreatorChannelID: null.StringFrom(creatorChannel.ClaimID),
-			BlockedListID:    blocklistID,
+
+		if modChannel.ClaimID != creatorChannel.ClaimID {
+			blockedEntry.DelegatedModeratorChannelID = null.StringFrom(modChannel.ClaimID)
 		}
-		err := blockedEntry.Insert(db.RW, boil.Infer())
+
+		err = blockedEntry.Update(tx, boil.Infer())
 		if err != nil {
 			return errors.Err(err)
 		}
-	} else {
-		blockedEntry.Strikes.SetValid(blockedEntry.Strikes.Int + 1)
-	}
-	if participatingBlockedList != nil && args.TimeOut > 0 {
-		return api.StatusError{Err: errors.Err("the block list rules you are participating have their time out hours settings per strike. You must stop participating in the shared blocked list to customize timeouts"), Status: http.StatusBadRequest}
-	} else if participatingBlockedList != nil {
-		blockedEntry.Expiry.SetValid(time.Now().Add(getStrikeDuration(blockedEntry.Strikes.Int, participatingBlockedList)))
-	} else if args.TimeOut > 0 {
-		blockedEntry.Expiry.SetValid(time.Now().Add(time.Duration(args.TimeOut) * time.Second))
-	}
-	isMod, err := modChannel.ModChannelModerators().Exists(db.RO)
+		event := blockEvent(blockedEntry, bannedChannel, modChannel)
+		err = webhooks.Publish(tx, commentapi.WebhookBlocked, creatorChannel.ClaimID, event)
+		if err != nil {
+			return err
+		}
+		err = helper.RecordChange(tx, commentapi.ChangeBlock, nil, bannedChannel.ClaimID, event)
+		if err != nil {
+			return err
+		}
+		audit := helper.Audit{
+			Action:           commentapi.ActionBlock,
+			ActorChannelID:   modChannel.ClaimID,
+			CreatorChannelID: creatorChannel.ClaimID,
+			TargetChannelID:  bannedChannel.ClaimID,
+			ClaimID:          args.ClaimID,
+			Before:           before,
+			After:            event,
+			Reason:           args.Reason,
+			APIKey:           apikeys.KeyName(r, apikeys.ScopeModeration),
+		}
+		if args.BlockAll {
+			audit.CreatorChannelID = ""
+		}
+		err = helper.RecordAction(tx, audit)
+		if err != nil {
+			return err
+		}
+		if args.BlockAll {
+			return nil
+		}
+		return rollup.Block(tx, creatorChannel.ClaimID, args.ClaimID)
+	})
 	if err != nil {
-		return errors.Err(err)
+		return err
 	}
 	if args.BlockAll {
-		if !isMod {
-			return api.StatusError{Err: errors.Err("cannot block universally without admin privileges"), Status: http.StatusForbidden}
-		}
-		blockedEntry.CreatorChannelID.SetValid(creatorChannel.ClaimID)
-		blockedEntry.UniversallyBlocked.SetValid(true)
 		reply.AllBlocked = true
 	} else {
 		reply.BannedFrom = &creatorChannel.ClaimID
 	}
-
-	if modChannel.ClaimID != creatorChannel.ClaimID {
-		blockedEntry.DelegatedModeratorChannelID = null.StringFrom(modChannel.ClaimID)
-	}
-
-	err = blockedEntry.Update(db.RW, boil.Infer())
-	if err != nil {
-		return errors.Err(err)
-	}
 	var deletedCommentIDs []string
 	if args.DeleteAll {
-		if !isMod {
-			return api.StatusError{Err: errors.Err("cannot delete all comments of user without admin priviledges"), Status: http.StatusForbidden}
-		}
-
 		comments, err := model.Comments(model.CommentWhere.ChannelID.EQ(null.StringFrom(bannedChannel.ClaimID))).All(db.RO)
 		if err != nil {
 			return errors.Err(err)
 		}
-		err = comments.DeleteAll(db.RW)
+		err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+			err := comments.DeleteAll(tx)
+			if err != nil {
+				return errors.Err(err)
+			}
+			for _, c := range comments {
+				if c.ParentID.Valid {
+					err := helper.AddReplies(tx, c.ParentID.String, -1)
+					if err != nil {
+						return err
+					}
+				}
+				err := helper.RecordChange(tx, commentapi.ChangeDelete, c, c.ChannelID.String, nil)
+				if err != nil {
+					return err
+				}
+			}
+			deleted := helper.Audit{
+				Action:          commentapi.ActionDelete,
+				ActorChannelID:  modChannel.ClaimID,
+				TargetChannelID: bannedChannel.ClaimID,
+				Before:          len(comments),
+				Reason:          args.Reason,
+				APIKey:          apikeys.KeyName(r, apikeys.ScopeModeration),
+			}
+			if !args.BlockAll {
+				deleted.CreatorChannelID = creatorChannel.ClaimID
+			}
+			return helper.RecordAction(tx, deleted)
+		})
 		if err != nil {
 			return errors.Err(err)
 		}
@@ -129,6 +230,22 @@ func block(_ *http.Request, args *commentapi.BlockArgs, reply *commentapi.BlockR
 	return nil
 }
 
+// blockEvent is the data of the webhook event of the block
+func blockEvent(entry *model.BlockedEntry, blocked, modChannel *model.Channel) commentapi.BlockEvent {
+	event := commentapi.BlockEvent{
+		BlockedChannelID:   blocked.ClaimID,
+		BlockedChannelName: blocked.Name,
+		CreatorChannelID:   entry.CreatorChannelID.String,
+		ModChannelID:       modChannel.ClaimID,
+		Universal:          entry.UniversallyBlocked.Bool,
+	}
+	if entry.Expiry.Valid {
+		expiry := entry.Expiry.Time.Unix()
+		event.Expiry = &expiry
+	}
+	return event
+}
+
 const defaultStrikeTimeout = 4 * time.Hour
 
 func getStrikeDuration(strike int, list *model.BlockedList) time.Duration {
@@ -143,37 +260,12 @@ func getStrikeDuration(strike int, list *model.BlockedList) time.Duration {
 	}
 }
 
-func getModerator(modChannelID, modChannelName, creatorChannelID, creatorChannelName string) (*model.Channel, *model.Channel, error) {
-	modChannel, err := helper.FindOrCreateChannel(modChannelID, modChannelName)
-	if err != nil {
-		return nil, nil, errors.Err(err)
-	}
-	var creatorChannel = modChannel
-	if creatorChannelID != "" && creatorChannelName != "" {
-		creatorChannel, err = helper.FindOrCreateChannel(creatorChannelID, creatorChannelName)
-		if err != nil {
-			return nil, nil, errors.Err(err)
-		}
-		dmRels := model.DelegatedModeratorRels
-		dmWhere := model.DelegatedModeratorWhere
-		loadCreatorChannels := qm.Load(dmRels.CreatorChannel, dmWhere.CreatorChannelID.EQ(creatorChannelID))
-		exists, err := modChannel.ModChannelDelegatedModerators(loadCreatorChannels).Exists(db.RO)
-		if err != nil {
-			return nil, nil, errors.Err(err)
-		}
-		if !exists {
-			return nil, nil, errors.Err("%s is not delegated by %s to be a moderator", modChannel.Name, creatorChannel.Name)
-		}
-	}
-	return modChannel, creatorChannel, nil
-}
-
-func blockedList(_ *http.Request, args *commentapi.BlockedListArgs, reply *commentapi.BlockedListResponse) error {
-	modChannel, _, err := getModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName)
+func blockedList(r *http.Request, args *commentapi.BlockedListArgs, reply *commentapi.BlockedListResponse) error {
+	modChannel, _, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, "", 0)
 	if err != nil {
 		return err
 	}
-	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, args.ModChannelName)
+	err = apikeys.ValidateSignature(r, apikeys.ScopeModeration, modChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("moderation.BlockedList", args.ModChannelName, args.CreatorChannelID))
 	if err != nil {
 		return err
 	}
@@ -213,7 +305,7 @@ func blockedList(_ *http.Request, args *commentapi.BlockedListArgs, reply *comme
 
 func getDelegatedEntries(modChannel *model.Channel) (model.BlockedEntrySlice, error) {
 	var blockedByCreator model.BlockedEntrySlice
-	moderations, err := modChannel.ModChannelDelegatedModerators(qm.Load(model.DelegatedModeratorRels.CreatorChannel)).All(db.RO)
+	moderations, err := modChannel.ModChannelDelegatedModerators(helper.ActiveDelegates(), qm.Load(model.DelegatedModeratorRels.CreatorChannel)).All(db.RO)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.Err(err)
 	}
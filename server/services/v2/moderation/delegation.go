@@ -3,30 +3,52 @@ package moderation
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
 	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/validator"
 
 	"github.com/lbryio/lbry.go/extras/api"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
 
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
 	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
-type delegatedModLevel int
-
-const defaultLevel = delegatedModLevel(0)
-
 func addDelegate(_ *http.Request, args *commentapi.AddDelegateArgs, reply *commentapi.ListDelegateResponse) error {
 	creatorChannel, err := helper.FindOrCreateChannel(args.CreatorChannelID, args.CreatorChannelName)
 	if err != nil {
 		return errors.Err(err)
 	}
 
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.CreatorChannelName)
+	digest := lbry.Call("moderation.AddDelegate", args.CreatorChannelName, args.CreatorChannelID, args.ModChannelID)
+	permissions := commentapi.PermissionAll
+	if args.Permissions != nil {
+		permissions = *args.Permissions
+	}
+	scopeFields := delegateScopeFields(args.ClaimIDs, args.ExpiresAt)
+	if args.Permissions != nil || scopeFields != nil {
+		digest.Fields = append(digest.Fields, strconv.FormatUint(permissions, 10))
+	}
+	digest.Fields = append(digest.Fields, scopeFields...)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, digest)
+	if err != nil {
+		return err
+	}
+	err = validatePermissions(permissions)
+	if err != nil {
+		return err
+	}
+	permissions = impliedPermissions(permissions)
+	claimScope, expiresAt, err := delegateScope(args.ClaimIDs, args.ExpiresAt)
 	if err != nil {
 		return err
 	}
@@ -38,41 +60,202 @@ func addDelegate(_ *http.Request, args *commentapi.AddDelegateArgs, reply *comme
 	if err != nil {
 		return errors.Err(err)
 	}
-	exists, err := creatorChannel.CreatorChannelDelegatedModerators(
-		model.DelegatedModeratorWhere.ModChannelID.EQ(modChannel.ClaimID),
-		model.DelegatedModeratorWhere.CreatorChannelID.EQ(creatorChannel.ClaimID)).Exists(db.RO)
+	delegatedModerator, err := creatorChannel.CreatorChannelDelegatedModerators(
+		model.DelegatedModeratorWhere.ModChannelID.EQ(modChannel.ClaimID)).One(db.RO)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Err(err)
+	}
+	if delegatedModerator != nil && delegatedModerator.Status == commentapi.DelegateStatusActive && !delegateExpired(delegatedModerator) {
+		return api.StatusError{Err: errors.Err("channel %s already is a moderation for %s", args.ModChannelName, args.CreatorChannelName), Status: http.StatusBadRequest}
+	}
+	if delegatedModerator != nil && !inviteExpired(delegatedModerator) && !delegateExpired(delegatedModerator) {
+		return api.StatusError{Err: errors.Err("channel %s already has a pending invite from %s", args.ModChannelName, args.CreatorChannelName), Status: http.StatusBadRequest}
+	}
+
+	inviteExpiresAt := time.Now().Add(delegateInviteExpiry)
+	if delegatedModerator != nil {
+		// re-invite after the previous invite or delegation expired
+		delegatedModerator.Permissons = permissions
+		delegatedModerator.ClaimScope = claimScope
+		delegatedModerator.ExpiresAt = expiresAt
+		delegatedModerator.Status = commentapi.DelegateStatusPending
+		delegatedModerator.InviteExpiresAt.SetValid(inviteExpiresAt)
+		err = delegatedModerator.Update(db.RW, boil.Whitelist(model.DelegatedModeratorColumns.Permissons, model.DelegatedModeratorColumns.ClaimScope,
+			model.DelegatedModeratorColumns.ExpiresAt, model.DelegatedModeratorColumns.Status, model.DelegatedModeratorColumns.InviteExpiresAt))
+	} else {
+		delegatedModerator = &model.DelegatedModerator{
+			ModChannelID:    modChannel.ClaimID,
+			Permissons:      permissions,
+			ClaimScope:      claimScope,
+			ExpiresAt:       expiresAt,
+			Status:          commentapi.DelegateStatusPending,
+			InviteExpiresAt: null.TimeFrom(inviteExpiresAt),
+		}
+		err = creatorChannel.AddCreatorChannelDelegatedModerators(db.RW, true, delegatedModerator)
+	}
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionAddDelegate,
+		ActorChannelID:   creatorChannel.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		TargetChannelID:  modChannel.ClaimID,
+		After:            populateDelegate(delegatedModerator, modChannel),
+	})
+	if err != nil {
+		return err
+	}
+
+	reply.Delegates = append(reply.Delegates, populateDelegate(delegatedModerator, modChannel))
+
+	return nil
+}
+
+func updateDelegate(_ *http.Request, args *commentapi.UpdateDelegateArgs, reply *commentapi.ListDelegateResponse) error {
+	creatorChannel, err := helper.FindOrCreateChannel(args.CreatorChannelID, args.CreatorChannelName)
 	if err != nil {
 		return errors.Err(err)
 	}
-	if exists {
-		return errors.Err("channel %s already is a moderation for %s", args.ModChannelName, args.CreatorChannelName)
+
+	digest := lbry.Call("moderation.UpdateDelegate", "", args.CreatorChannelID, args.ModChannelID, strconv.FormatUint(args.Permissions, 10))
+	digest.Fields = append(digest.Fields, delegateScopeFields(args.ClaimIDs, args.ExpiresAt)...)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, digest)
+	if err != nil {
+		return err
+	}
+	err = validatePermissions(args.Permissions)
+	if err != nil {
+		return err
+	}
+	claimScope, expiresAt, err := delegateScope(args.ClaimIDs, args.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	modChannel, err := helper.FindOrCreateChannel(args.ModChannelID, args.ModChannelName)
+	if err != nil {
+		return errors.Err(err)
 	}
 
-	delegatedModerator := &model.DelegatedModerator{
-		ModChannelID: modChannel.ClaimID,
-		Permissons:   uint64(defaultLevel),
+	modEntry, err := creatorChannel.CreatorChannelDelegatedModerators(model.DelegatedModeratorWhere.ModChannelID.EQ(modChannel.ClaimID)).One(db.RO)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Err(err)
+	}
+	if modEntry == nil {
+		return api.StatusError{Err: errors.Err("Mod channel %s is not a moderator for channel %s", args.ModChannelName, args.CreatorChannelName), Status: http.StatusBadRequest}
 	}
 
-	err = creatorChannel.AddCreatorChannelDelegatedModerators(db.RW, true, delegatedModerator)
+	before := populateDelegate(modEntry, modChannel)
+	modEntry.Permissons = impliedPermissions(args.Permissions)
+	modEntry.ClaimScope = claimScope
+	modEntry.ExpiresAt = expiresAt
+	err = modEntry.Update(db.RW, boil.Whitelist(model.DelegatedModeratorColumns.Permissons, model.DelegatedModeratorColumns.ClaimScope,
+		model.DelegatedModeratorColumns.ExpiresAt))
 	if err != nil {
 		return errors.Err(err)
 	}
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionUpdateDelegate,
+		ActorChannelID:   creatorChannel.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		TargetChannelID:  modChannel.ClaimID,
+		Before:           before,
+		After:            populateDelegate(modEntry, modChannel),
+	})
+	if err != nil {
+		return err
+	}
 
-	reply.Delegates = append(reply.Delegates, commentapi.Delegate{
+	reply.Delegates = append(reply.Delegates, populateDelegate(modEntry, modChannel))
+
+	return nil
+}
+
+// populateDelegate returns the delegate with its status, expired invites are reported as such
+func populateDelegate(delegate *model.DelegatedModerator, modChannel *model.Channel) commentapi.Delegate {
+	item := commentapi.Delegate{
 		ChannelID:   modChannel.ClaimID,
 		ChannelName: modChannel.Name,
-	})
+		Permissions: delegate.Permissons,
+		Status:      delegate.Status,
+		ExpiresAt:   delegate.ExpiresAt.Ptr(),
+	}
+	if delegate.ClaimScope.Valid {
+		item.ClaimIDs = strings.Split(delegate.ClaimScope.String, ",")
+	}
+	if delegate.Status == commentapi.DelegateStatusPending {
+		item.InviteExpiresAt = delegate.InviteExpiresAt.Ptr()
+		if inviteExpired(delegate) {
+			item.Status = commentapi.DelegateStatusExpired
+		}
+	}
+	if delegateExpired(delegate) {
+		item.Status = commentapi.DelegateStatusExpired
+	}
+	return item
+}
 
+// delegateScope validates the claim scope and expiry of a delegate and returns them as they are stored
+func delegateScope(claimIDs []string, expiresAt *int64) (null.String, null.Time, error) {
+	var claimScope null.String
+	var expiry null.Time
+	for _, claimID := range claimIDs {
+		err := v.Validate(claimID, v.Required, validator.ClaimID)
+		if err != nil {
+			return claimScope, expiry, api.StatusError{Err: errors.Prefix("invalid claim id "+claimID, err), Status: http.StatusBadRequest}
+		}
+	}
+	if len(claimIDs) > 0 {
+		claimScope.SetValid(strings.Join(claimIDs, ","))
+	}
+	if expiresAt != nil {
+		expiry.SetValid(time.Unix(*expiresAt, 0))
+		if !expiry.Time.After(time.Now()) {
+			return claimScope, expiry, api.StatusError{Err: errors.Err("a delegate can only expire in the future"), Status: http.StatusBadRequest}
+		}
+	}
+	return claimScope, expiry, nil
+}
+
+// delegateScopeFields returns the signed fields of a claim scope and expiry, none when neither is passed
+func delegateScopeFields(claimIDs []string, expiresAt *int64) []string {
+	if len(claimIDs) == 0 && expiresAt == nil {
+		return nil
+	}
+	expiry := ""
+	if expiresAt != nil {
+		expiry = strconv.FormatInt(*expiresAt, 10)
+	}
+	return []string{strings.Join(claimIDs, ","), expiry}
+}
+
+// validatePermissions checks the permissions of a delegate are known
+func validatePermissions(permissions uint64) error {
+	if permissions == 0 {
+		return api.StatusError{Err: errors.Err("a delegate needs at least one permission"), Status: http.StatusBadRequest}
+	}
+	if permissions&^commentapi.PermissionAll != 0 {
+		return api.StatusError{Err: errors.Err("unknown permissions %d, the permissions must be a combination of %d", permissions, commentapi.PermissionAll), Status: http.StatusBadRequest}
+	}
 	return nil
 }
 
+// impliedPermissions adds the permissions included in the others, PermissionBlock allows the blocks that expire too
+func impliedPermissions(permissions uint64) uint64 {
+	if permissions&commentapi.PermissionBlock != 0 {
+		permissions |= commentapi.PermissionTimeout
+	}
+	return permissions
+}
+
 func removeDelegate(_ *http.Request, args *commentapi.RemoveDelegateArgs, reply *commentapi.ListDelegateResponse) error {
 	creatorChannel, err := helper.FindOrCreateChannel(args.CreatorChannelID, args.CreatorChannelName)
 	if err != nil {
 		return errors.Err(err)
 	}
 
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.CreatorChannelName)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call("moderation.RemoveDelegate", args.CreatorChannelName, args.CreatorChannelID, args.ModChannelID))
 	if err != nil {
 		return err
 	}
@@ -88,18 +271,25 @@ func removeDelegate(_ *http.Request, args *commentapi.RemoveDelegateArgs, reply
 	}
 
 	if modEntry == nil {
-		return errors.Err("Mod channel %s is not a moderator for channel %s", args.ModChannelName, args.CreatorChannelName)
+		return api.StatusError{Err: errors.Err("Mod channel %s is not a moderator for channel %s", args.ModChannelName, args.CreatorChannelName), Status: http.StatusBadRequest}
 	}
 
 	err = modEntry.Delete(db.RW)
 	if err != nil {
 		return errors.Err(err)
 	}
-
-	reply.Delegates = append(reply.Delegates, commentapi.Delegate{
-		ChannelID:   modChannel.ClaimID,
-		ChannelName: modChannel.Name,
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionRemoveDelegate,
+		ActorChannelID:   creatorChannel.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		TargetChannelID:  modChannel.ClaimID,
+		Before:           modEntry.Permissons,
 	})
+	if err != nil {
+		return err
+	}
+
+	reply.Delegates = append(reply.Delegates, populateDelegate(modEntry, modChannel))
 
 	return nil
 }
@@ -110,7 +300,7 @@ func listDelegates(_ *http.Request, args *commentapi.ListDelegatesArgs, reply *c
 		return errors.Err(err)
 	}
 
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.CreatorChannelName)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("moderation.ListDelegates", args.CreatorChannelName, args.CreatorChannelID))
 	if err != nil {
 		return err
 	}
@@ -121,10 +311,7 @@ func listDelegates(_ *http.Request, args *commentapi.ListDelegatesArgs, reply *c
 	}
 
 	for _, m := range delegatedModEntries {
-		reply.Delegates = append(reply.Delegates, commentapi.Delegate{
-			ChannelID:   m.R.ModChannel.ClaimID,
-			ChannelName: m.R.ModChannel.Name,
-		})
+		reply.Delegates = append(reply.Delegates, populateDelegate(m, m.R.ModChannel))
 	}
 
 	return nil
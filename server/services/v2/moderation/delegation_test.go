@@ -0,0 +1,73 @@
+package moderation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+)
+
+func TestValidatePermissions(t *testing.T) {
+	tests := []struct {
+		permissions uint64
+		valid       bool
+	}{
+		{0, false},
+		{commentapi.PermissionBlock, true},
+		{commentapi.PermissionTimeout, true},
+		{commentapi.PermissionTimeout | commentapi.PermissionDelete, true},
+		{commentapi.PermissionAll, true},
+		{commentapi.PermissionAll + 1, false},
+		{commentapi.PermissionBlock | 1<<20, false},
+	}
+	for _, test := range tests {
+		err := validatePermissions(test.permissions)
+		if (err == nil) != test.valid {
+			t.Errorf("permissions %d should be valid: %v, got %v", test.permissions, test.valid, err)
+		}
+	}
+}
+
+func TestImpliedPermissions(t *testing.T) {
+	tests := []struct {
+		permissions uint64
+		implied     uint64
+	}{
+		{commentapi.PermissionBlock, commentapi.PermissionBlock | commentapi.PermissionTimeout},
+		{commentapi.PermissionTimeout, commentapi.PermissionTimeout},
+		{commentapi.PermissionPin, commentapi.PermissionPin},
+		{commentapi.PermissionAll, commentapi.PermissionAll},
+	}
+	for _, test := range tests {
+		if got := impliedPermissions(test.permissions); got != test.implied {
+			t.Errorf("impliedPermissions(%d) = %d, expected %d", test.permissions, got, test.implied)
+		}
+	}
+}
+
+func TestDelegateExpired(t *testing.T) {
+	past := null.TimeFrom(time.Now().Add(-time.Hour))
+	future := null.TimeFrom(time.Now().Add(time.Hour))
+	tests := []struct {
+		delegate *model.DelegatedModerator
+		expired  bool
+		status   string
+	}{
+		{&model.DelegatedModerator{Status: commentapi.DelegateStatusActive}, false, commentapi.DelegateStatusActive},
+		{&model.DelegatedModerator{Status: commentapi.DelegateStatusActive, ExpiresAt: future}, false, commentapi.DelegateStatusActive},
+		{&model.DelegatedModerator{Status: commentapi.DelegateStatusActive, ExpiresAt: past}, true, commentapi.DelegateStatusExpired},
+		{&model.DelegatedModerator{Status: commentapi.DelegateStatusPending, ExpiresAt: past, InviteExpiresAt: future}, false, commentapi.DelegateStatusPending},
+		{&model.DelegatedModerator{Status: commentapi.DelegateStatusPending, InviteExpiresAt: past}, false, commentapi.DelegateStatusExpired},
+	}
+	for i, test := range tests {
+		if delegateExpired(test.delegate) != test.expired {
+			t.Errorf("delegate %d should be expired: %v", i, test.expired)
+		}
+		if status := populateDelegate(test.delegate, &model.Channel{}).Status; status != test.status {
+			t.Errorf("delegate %d should be listed as %s, got %s", i, test.status, status)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package moderation
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// delegateInviteExpiry is how long an invited channel has to accept becoming a moderator of the creator
+const delegateInviteExpiry = 7 * 24 * time.Hour
+
+// inviteExpired returns whether the delegate is still pending after its invite expired
+func inviteExpired(delegate *model.DelegatedModerator) bool {
+	return delegate.Status == commentapi.DelegateStatusPending && delegate.InviteExpiresAt.Valid && delegate.InviteExpiresAt.Time.Before(time.Now())
+}
+
+// delegateExpired returns whether the delegate accepted the invite but is no longer a moderator since its expiry passed,
+// see helper.ActiveDelegates
+func delegateExpired(delegate *model.DelegatedModerator) bool {
+	return delegate.Status == commentapi.DelegateStatusActive && delegate.ExpiresAt.Valid && !delegate.ExpiresAt.Time.After(time.Now())
+}
+
+func acceptDelegate(_ *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	modChannel, creatorChannel, delegate, err := findInvite(args, "moderation.AcceptDelegate", commentapi.DelegateStatusPending)
+	if err != nil {
+		return err
+	}
+	if inviteExpired(delegate) {
+		return api.StatusError{Err: errors.Err("the invite of %s expired, they can invite you again", creatorChannel.Name), Status: http.StatusBadRequest}
+	}
+
+	delegate.Status = commentapi.DelegateStatusActive
+	delegate.InviteExpiresAt = null.Time{}
+	err = delegate.Update(db.RW, boil.Whitelist(model.DelegatedModeratorColumns.Status, model.DelegatedModeratorColumns.InviteExpiresAt))
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = recordInvite(commentapi.ActionAcceptDelegate, modChannel, creatorChannel, delegate)
+	if err != nil {
+		return err
+	}
+
+	reply.Delegates = append(reply.Delegates, populateDelegate(delegate, modChannel))
+	return nil
+}
+
+func declineDelegate(_ *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	modChannel, creatorChannel, delegate, err := findInvite(args, "moderation.DeclineDelegate", commentapi.DelegateStatusPending)
+	if err != nil {
+		return err
+	}
+
+	err = delegate.Delete(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = recordInvite(commentapi.ActionDeclineDelegate, modChannel, creatorChannel, delegate)
+	if err != nil {
+		return err
+	}
+
+	reply.Delegates = append(reply.Delegates, populateDelegate(delegate, modChannel))
+	return nil
+}
+
+func resignDelegate(_ *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	modChannel, creatorChannel, delegate, err := findInvite(args, "moderation.ResignDelegate", commentapi.DelegateStatusActive)
+	if err != nil {
+		return err
+	}
+
+	err = delegate.Delete(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = recordInvite(commentapi.ActionResignDelegate, modChannel, creatorChannel, delegate)
+	if err != nil {
+		return err
+	}
+
+	reply.Delegates = append(reply.Delegates, populateDelegate(delegate, modChannel))
+	return nil
+}
+
+// findInvite validates the mod channel signed the call to method and returns its delegation by the creator, which must
+// have the status
+func findInvite(args *commentapi.DelegateInviteArgs, method, status string) (*model.Channel, *model.Channel, *model.DelegatedModerator, error) {
+	modChannel, err := helper.FindOrCreateChannel(args.ModChannelID, args.ModChannelName)
+	if err != nil {
+		return nil, nil, nil, errors.Err(err)
+	}
+	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call(method, "", args.ModChannelID, args.CreatorChannelID))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	creatorChannel, err := helper.FindOrCreateChannel(args.CreatorChannelID, args.CreatorChannelName)
+	if err != nil {
+		return nil, nil, nil, errors.Err(err)
+	}
+
+	delegate, err := modChannel.ModChannelDelegatedModerators(
+		model.DelegatedModeratorWhere.CreatorChannelID.EQ(creatorChannel.ClaimID),
+		model.DelegatedModeratorWhere.Status.EQ(status)).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) {
+		if status == commentapi.DelegateStatusPending {
+			return nil, nil, nil, api.StatusError{Err: errors.Err("%s has no pending invite from %s", modChannel.Name, creatorChannel.Name), Status: http.StatusBadRequest}
+		}
+		return nil, nil, nil, api.StatusError{Err: errors.Err("%s is not a moderator for %s", modChannel.Name, creatorChannel.Name), Status: http.StatusBadRequest}
+	}
+	if err != nil {
+		return nil, nil, nil, errors.Err(err)
+	}
+	return modChannel, creatorChannel, delegate, nil
+}
+
+// recordInvite adds the answer of the mod channel to the invite to the moderation audit log
+func recordInvite(action string, modChannel, creatorChannel *model.Channel, delegate *model.DelegatedModerator) error {
+	audit := helper.Audit{
+		Action:           action,
+		ActorChannelID:   modChannel.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		TargetChannelID:  modChannel.ClaimID,
+		Before:           delegate.Permissons,
+	}
+	if action == commentapi.ActionAcceptDelegate {
+		audit.Before, audit.After = nil, delegate.Permissons
+	}
+	return helper.RecordAction(db.RW, audit)
+}
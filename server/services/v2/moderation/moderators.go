@@ -0,0 +1,137 @@
+package moderation
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/flags"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/apikeys"
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/moderators"
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+func addModerator(r *http.Request, args *commentapi.AddModeratorArgs, reply *commentapi.ModeratorsResponse) error {
+	err := v.ValidateStruct(args,
+		v.Field(&args.ChannelID, validator.ClaimID, v.Required),
+		v.Field(&args.ChannelName, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	if args.Level == 0 {
+		args.Level = commentapi.ModLevelBlock
+	}
+	actorChannelID, err := moderators.Authorize(r, args.ModChannelID, args.ModChannelName, commentapi.ModLevelAdmin, args.Signature, args.SigningTS,
+		lbry.Call("moderation.AddModerator", "", args.ChannelID, strconv.FormatInt(args.Level, 10)))
+	if err != nil {
+		return err
+	}
+	channel, err := helper.FindOrCreateChannel(args.ChannelID, args.ChannelName)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = moderators.Set(channel, args.Level, actorChannelID, apikeys.KeyName(r, apikeys.ScopeModerators), args.Reason)
+	if err != nil {
+		return err
+	}
+	reply.Moderators, err = moderators.List()
+	return err
+}
+
+func removeModerator(r *http.Request, args *commentapi.RemoveModeratorArgs, reply *commentapi.ModeratorsResponse) error {
+	err := v.ValidateStruct(args,
+		v.Field(&args.ChannelID, validator.ClaimID, v.Required),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	actorChannelID, err := moderators.Authorize(r, args.ModChannelID, args.ModChannelName, commentapi.ModLevelAdmin, args.Signature, args.SigningTS,
+		lbry.Call("moderation.RemoveModerator", "", args.ChannelID))
+	if err != nil {
+		return err
+	}
+	err = moderators.Remove(args.ChannelID, actorChannelID, apikeys.KeyName(r, apikeys.ScopeModerators), args.Reason)
+	if err != nil {
+		return err
+	}
+	reply.Moderators, err = moderators.List()
+	return err
+}
+
+func listModerators(r *http.Request, args *commentapi.ListModeratorsArgs, reply *commentapi.ModeratorsResponse) error {
+	_, err := moderators.Authorize(r, args.ModChannelID, args.ModChannelName, commentapi.ModLevelBlock, args.Signature, args.SigningTS,
+		lbry.ReadOnly("moderation.ListModerators", "", args.ModChannelID))
+	if err != nil {
+		return err
+	}
+	reply.Moderators, err = moderators.List()
+	return err
+}
+
+func manageSpammer(_ *http.Request, args *commentapi.ManageSpammerArgs, reply *commentapi.ManageSpammerResponse) error {
+	err := v.ValidateStruct(args,
+		v.Field(&args.ModChannelID, validator.ClaimID, v.Required),
+		v.Field(&args.ModChannelName, v.Required),
+		v.Field(&args.ChannelID, validator.ClaimID, v.Required),
+		v.Field(&args.Kind, v.Required, v.In(flags.SpamComment, flags.SpamReaction)),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	modChannel, err := helper.FindOrCreateChannel(args.ModChannelID, args.ModChannelName)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call("moderation.ManageSpammer", "",
+		args.ChannelID, args.Kind, strconv.FormatBool(args.Remove)))
+	if err != nil {
+		return err
+	}
+	err = moderators.RequireLevel(modChannel, commentapi.ModLevelAdmin)
+	if err != nil {
+		return err
+	}
+
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		spammers, err := model.Spammers(model.SpammerWhere.ChannelID.EQ(args.ChannelID), model.SpammerWhere.Kind.EQ(args.Kind)).All(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if args.Remove {
+			err = spammers.DeleteAll(tx)
+		} else if len(spammers) == 0 {
+			err = (&model.Spammer{ChannelID: args.ChannelID, Kind: args.Kind}).Insert(tx, boil.Infer())
+		}
+		if err != nil {
+			return errors.Err(err)
+		}
+		return helper.RecordAction(tx, helper.Audit{
+			Action:          commentapi.ActionManageSpammer,
+			ActorChannelID:  modChannel.ClaimID,
+			TargetChannelID: args.ChannelID,
+			Before:          map[string]bool{args.Kind: len(spammers) > 0},
+			After:           map[string]bool{args.Kind: !args.Remove},
+			Reason:          args.Reason,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	flags.ForgetSpammer(args.Kind, args.ChannelID)
+
+	reply.ChannelID = args.ChannelID
+	reply.Kind = args.Kind
+	reply.Spammer = !args.Remove
+	return nil
+}
@@ -0,0 +1,157 @@
+package moderation
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/apikeys"
+	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/websocket"
+	"github.com/lbryio/commentron/sockety"
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/extras/util"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/lbryio/sockety/socketyapi"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+func purge(r *http.Request, args *commentapi.PurgeArgs, reply *commentapi.PurgeResponse) error {
+	err := v.ValidateStruct(args,
+		v.Field(&args.ClaimID, validator.ClaimID, v.Required),
+		v.Field(&args.ModChannelID, validator.ClaimID, v.Required),
+		v.Field(&args.ModChannelName, v.Required),
+		v.Field(&args.PurgedChannelID, validator.ClaimID),
+	)
+	if err != nil {
+		return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+	}
+	modChannel, creatorChannel, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, args.ClaimID, commentapi.PermissionHide)
+	if err != nil {
+		return err
+	}
+	err = apikeys.ValidateSignature(r, apikeys.ScopeModeration, modChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call("moderation.Purge", "",
+		args.CreatorChannelID, args.ClaimID, util.StrFromPtr(args.PurgedChannelID), strconv.FormatUint(args.Window, 10)))
+	if err != nil {
+		return err
+	}
+
+	err = checkPurgedClaim(modChannel, creatorChannel, args.ClaimID)
+	if err != nil {
+		return err
+	}
+
+	var purgedCommentIDs []string
+	err = db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		comments, err := model.Comments(append(purgeFilters(args, time.Now()), qm.For("UPDATE"))...).All(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if len(comments) == 0 {
+			return nil
+		}
+		err = comments.UpdateAll(tx, model.M{model.CommentColumns.IsHidden: true})
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, c := range comments {
+			err := helper.RecordChange(tx, commentapi.ChangeHide, c, c.ChannelID.String, nil)
+			if err != nil {
+				return err
+			}
+			purgedCommentIDs = append(purgedCommentIDs, c.CommentID)
+		}
+		return helper.RecordAction(tx, helper.Audit{
+			Action:           commentapi.ActionPurge,
+			ActorChannelID:   modChannel.ClaimID,
+			CreatorChannelID: creatorChannel.ClaimID,
+			TargetChannelID:  util.StrFromPtr(args.PurgedChannelID),
+			ClaimID:          args.ClaimID,
+			After:            purgedCommentIDs,
+			Reason:           args.Reason,
+			APIKey:           apikeys.KeyName(r, apikeys.ScopeModeration),
+		})
+	})
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	reply.PurgedCommentIDs = purgedCommentIDs
+	if len(purgedCommentIDs) > 0 {
+		go pushPurge(args.ClaimID, args.PurgedChannelID, purgedCommentIDs)
+	}
+	return nil
+}
+
+// checkPurgedClaim makes sure the claim is signed by the creator, global moderators can purge any claim
+func checkPurgedClaim(modChannel, creatorChannel *model.Channel, claimID string) error {
+	isMod, err := modChannel.ModChannelModerators().Exists(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if isMod {
+		return nil
+	}
+	signingChannel, err := lbry.SDK.GetSigningChannelForClaim(claimID)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if signingChannel == nil || signingChannel.ClaimID != creatorChannel.ClaimID {
+		return api.StatusError{Err: errors.Err("claim %s is not signed by %s", claimID, creatorChannel.Name), Status: http.StatusForbidden}
+	}
+	return nil
+}
+
+// purgeFilters filters to the comments of the claim that are not hidden yet, of the purged channel and in the window
+// before now if passed
+func purgeFilters(args *commentapi.PurgeArgs, now time.Time) []qm.QueryMod {
+	filters := []qm.QueryMod{
+		model.CommentWhere.LbryClaimID.EQ(args.ClaimID),
+		qm.Where("("+model.CommentColumns.IsHidden+" IS NULL OR "+model.CommentColumns.IsHidden+" = ?)", false),
+	}
+	if args.PurgedChannelID != nil {
+		filters = append(filters, model.CommentWhere.ChannelID.EQ(null.StringFromPtr(args.PurgedChannelID)))
+	}
+	if args.Window > 0 {
+		since := now.Add(-time.Duration(args.Window) * time.Second)
+		filters = append(filters, model.CommentWhere.Timestamp.GTE(int(since.Unix())))
+	}
+	return filters
+}
+
+// purgeData is the data of the purge event pushed to live chat
+func purgeData(claimID string, purgedChannelID *string, commentIDs []string) map[string]interface{} {
+	data := map[string]interface{}{
+		"claim_id":    claimID,
+		"comment_ids": commentIDs,
+	}
+	if purgedChannelID != nil {
+		data["channel_id"] = *purgedChannelID
+	}
+	return data
+}
+
+func pushPurge(claimID string, purgedChannelID *string, commentIDs []string) {
+	data := purgeData(claimID, purgedChannelID, commentIDs)
+	websocket.PushTo(&websocket.PushNotification{
+		Type: "purge",
+		Data: data,
+	}, claimID)
+
+	go sockety.SendNotification(socketyapi.SendNotificationArgs{
+		Service: socketyapi.Commentron,
+		Type:    "purge",
+		IDs:     []string{claimID, "comments"},
+		Data:    data,
+	})
+}
@@ -0,0 +1,173 @@
+package moderation
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/db/dbtest"
+	"github.com/lbryio/commentron/env"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/jsonrpc"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+)
+
+var (
+	purgeCreator   = &model.Channel{ClaimID: strings.Repeat("1", 40), Name: "@creator"}
+	purgeDelegate  = &model.Channel{ClaimID: strings.Repeat("2", 40), Name: "@delegate"}
+	purgeGlobalMod = &model.Channel{ClaimID: strings.Repeat("3", 40), Name: "@globalmod"}
+	purgeUser      = &model.Channel{ClaimID: strings.Repeat("4", 40), Name: "@user"}
+	purgeOther     = &model.Channel{ClaimID: strings.Repeat("5", 40), Name: "@other"}
+	purgeClaimID   = strings.Repeat("a", 40)
+	otherClaimID   = strings.Repeat("b", 40)
+)
+
+func TestPurgeFilters(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		args     commentapi.PurgeArgs
+		expected []interface{}
+	}{
+		{commentapi.PurgeArgs{ClaimID: purgeClaimID}, []interface{}{purgeClaimID, false}},
+		{commentapi.PurgeArgs{ClaimID: purgeClaimID, PurgedChannelID: &purgeUser.ClaimID}, []interface{}{purgeClaimID, false, null.StringFrom(purgeUser.ClaimID)}},
+		{commentapi.PurgeArgs{ClaimID: purgeClaimID, Window: 600}, []interface{}{purgeClaimID, false, int(now.Add(-10 * time.Minute).Unix())}},
+	}
+	for _, test := range tests {
+		_, args := queries.BuildQuery(model.Comments(purgeFilters(&test.args, now)...).Query)
+		if !reflect.DeepEqual(args, test.expected) {
+			t.Errorf("%+v: expected args %v got %v", test.args, test.expected, args)
+		}
+	}
+}
+
+func TestPurgeData(t *testing.T) {
+	commentIDs := []string{"comment1", "comment2"}
+	data := purgeData(purgeClaimID, nil, commentIDs)
+	if data["claim_id"] != purgeClaimID || !reflect.DeepEqual(data["comment_ids"], commentIDs) {
+		t.Errorf("unexpected purge event %v", data)
+	}
+	if _, ok := data["channel_id"]; ok {
+		t.Errorf("a purge of all channels should not have a channel_id, got %v", data)
+	}
+	data = purgeData(purgeClaimID, &purgeUser.ClaimID, commentIDs)
+	if data["channel_id"] != purgeUser.ClaimID {
+		t.Errorf("expected the purged channel in the event, got %v", data)
+	}
+}
+
+func initPurge(t *testing.T) {
+	dbtest.Init(t, model.TableNames.Comment, model.TableNames.DelegatedModerator, model.TableNames.Moderator)
+	for _, c := range []*model.Channel{purgeCreator, purgeDelegate, purgeGlobalMod, purgeUser, purgeOther} {
+		_, err := helper.FindOrCreateChannel(c.ClaimID, c.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	lbry.Init(&env.Config{})
+	lbry.SetMockSigningChannel(purgeClaimID, &jsonrpc.Claim{ClaimID: purgeCreator.ClaimID})
+	lbry.SetMockSigningChannel(otherClaimID, &jsonrpc.Claim{ClaimID: purgeOther.ClaimID})
+}
+
+func TestPurgeAuthorization(t *testing.T) {
+	initPurge(t)
+	delegates := model.DelegatedModeratorSlice{
+		{ModChannelID: purgeDelegate.ClaimID, CreatorChannelID: purgeCreator.ClaimID, Permissons: commentapi.PermissionHide, Status: commentapi.DelegateStatusActive},
+		{ModChannelID: purgeUser.ClaimID, CreatorChannelID: purgeCreator.ClaimID, Permissons: commentapi.PermissionBlock, Status: commentapi.DelegateStatusActive},
+		{ModChannelID: purgeOther.ClaimID, CreatorChannelID: purgeCreator.ClaimID, Permissons: commentapi.PermissionHide, Status: commentapi.DelegateStatusActive, ClaimScope: null.StringFrom(otherClaimID)},
+	}
+	for _, d := range delegates {
+		err := d.Insert(db.RW, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	globalMod := &model.Moderator{ModChannelID: null.StringFrom(purgeGlobalMod.ClaimID), ModLevel: 1}
+	err := globalMod.Insert(db.RW, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		mod     *model.Channel
+		creator *model.Channel
+		claimID string
+		allowed bool
+	}{
+		{"creator", purgeCreator, nil, purgeClaimID, true},
+		{"creator on a claim of another channel", purgeCreator, nil, otherClaimID, false},
+		{"delegate", purgeDelegate, purgeCreator, purgeClaimID, true},
+		{"delegate without the hide permission", purgeUser, purgeCreator, purgeClaimID, false},
+		{"delegate scoped to another claim", purgeOther, purgeCreator, purgeClaimID, false},
+		{"not a delegate", purgeGlobalMod, purgeCreator, purgeClaimID, false},
+		{"global moderator", purgeGlobalMod, nil, purgeClaimID, true},
+		{"channel on a claim of another channel", purgeUser, nil, purgeClaimID, false},
+	}
+	for _, test := range tests {
+		var creatorID, creatorName string
+		if test.creator != nil {
+			creatorID, creatorName = test.creator.ClaimID, test.creator.Name
+		}
+		modChannel, creatorChannel, err := helper.GetModerator(test.mod.ClaimID, test.mod.Name, creatorID, creatorName, test.claimID, commentapi.PermissionHide)
+		if err == nil {
+			err = checkPurgedClaim(modChannel, creatorChannel, test.claimID)
+		}
+		if (err == nil) != test.allowed {
+			t.Errorf("%s: expected allowed %t, got %v", test.name, test.allowed, err)
+		}
+	}
+}
+
+func TestPurgeComments(t *testing.T) {
+	initPurge(t)
+	now := time.Now()
+	comments := model.CommentSlice{
+		{CommentID: strings.Repeat("1", 64), LbryClaimID: purgeClaimID, ChannelID: null.StringFrom(purgeUser.ClaimID), Timestamp: int(now.Add(-time.Hour).Unix())},
+		{CommentID: strings.Repeat("2", 64), LbryClaimID: purgeClaimID, ChannelID: null.StringFrom(purgeUser.ClaimID), Timestamp: int(now.Unix())},
+		{CommentID: strings.Repeat("3", 64), LbryClaimID: purgeClaimID, ChannelID: null.StringFrom(purgeOther.ClaimID), Timestamp: int(now.Unix())},
+		{CommentID: strings.Repeat("4", 64), LbryClaimID: purgeClaimID, ChannelID: null.StringFrom(purgeOther.ClaimID), Timestamp: int(now.Unix()), IsHidden: null.BoolFrom(true)},
+		{CommentID: strings.Repeat("5", 64), LbryClaimID: otherClaimID, ChannelID: null.StringFrom(purgeUser.ClaimID), Timestamp: int(now.Unix())},
+	}
+	for _, c := range comments {
+		c.Body = "purge me"
+		err := c.Insert(db.RW, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		args     commentapi.PurgeArgs
+		expected []string
+	}{
+		{"claim", commentapi.PurgeArgs{ClaimID: purgeClaimID}, []string{comments[0].CommentID, comments[1].CommentID, comments[2].CommentID}},
+		{"channel", commentapi.PurgeArgs{ClaimID: purgeClaimID, PurgedChannelID: &purgeUser.ClaimID}, []string{comments[0].CommentID, comments[1].CommentID}},
+		{"window", commentapi.PurgeArgs{ClaimID: purgeClaimID, Window: 600}, []string{comments[1].CommentID, comments[2].CommentID}},
+		{"channel in window", commentapi.PurgeArgs{ClaimID: purgeClaimID, PurgedChannelID: &purgeUser.ClaimID, Window: 600}, []string{comments[1].CommentID}},
+	}
+	for _, test := range tests {
+		purged, err := model.Comments(purgeFilters(&test.args, now)...).All(db.RO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, c := range purged {
+			ids = append(ids, c.CommentID)
+		}
+		sort.Strings(ids)
+		if !reflect.DeepEqual(ids, test.expected) {
+			t.Errorf("%s: expected %v got %v", test.name, test.expected, ids)
+		}
+	}
+}
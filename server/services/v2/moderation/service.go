@@ -34,6 +34,26 @@ func (s Service) AddDelegate(r *http.Request, args *commentapi.AddDelegateArgs,
 	return addDelegate(r, args, reply)
 }
 
+// UpdateDelegate changes the permissions of a delegated moderator
+func (s Service) UpdateDelegate(r *http.Request, args *commentapi.UpdateDelegateArgs, reply *commentapi.ListDelegateResponse) error {
+	return updateDelegate(r, args, reply)
+}
+
+// AcceptDelegate accepts the invite of a creator to moderate for them
+func (s Service) AcceptDelegate(r *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	return acceptDelegate(r, args, reply)
+}
+
+// DeclineDelegate declines the invite of a creator to moderate for them
+func (s Service) DeclineDelegate(r *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	return declineDelegate(r, args, reply)
+}
+
+// ResignDelegate stops moderating for a creator
+func (s Service) ResignDelegate(r *http.Request, args *commentapi.DelegateInviteArgs, reply *commentapi.ListDelegateResponse) error {
+	return resignDelegate(r, args, reply)
+}
+
 // RemoveDelegate return the list of blocked channels for a moderator
 func (s Service) RemoveDelegate(r *http.Request, args *commentapi.RemoveDelegateArgs, reply *commentapi.ListDelegateResponse) error {
 	return removeDelegate(r, args, reply)
@@ -43,3 +63,35 @@ func (s Service) RemoveDelegate(r *http.Request, args *commentapi.RemoveDelegate
 func (s Service) ListDelegates(r *http.Request, args *commentapi.ListDelegatesArgs, reply *commentapi.ListDelegateResponse) error {
 	return listDelegates(r, args, reply)
 }
+
+// Purge hides the comments on a claim, optionally just those of one channel within a recent time window
+func (s Service) Purge(r *http.Request, args *commentapi.PurgeArgs, reply *commentapi.PurgeResponse) error {
+	return purge(r, args, reply)
+}
+
+// AuditLog returns the moderation actions taken by a creator and their delegates, or by anyone for global moderators
+func (s Service) AuditLog(r *http.Request, args *commentapi.AuditLogArgs, reply *commentapi.AuditLogResponse) error {
+	return auditLog(r, args, reply)
+}
+
+// AddModerator makes a channel a global moderator or changes its level, it requires an api key with the moderators
+// scope or a level 3 global moderator
+func (s Service) AddModerator(r *http.Request, args *commentapi.AddModeratorArgs, reply *commentapi.ModeratorsResponse) error {
+	return addModerator(r, args, reply)
+}
+
+// RemoveModerator removes a global moderator, it requires an api key with the moderators scope or a level 3 global
+// moderator
+func (s Service) RemoveModerator(r *http.Request, args *commentapi.RemoveModeratorArgs, reply *commentapi.ModeratorsResponse) error {
+	return removeModerator(r, args, reply)
+}
+
+// ListModerators returns the global moderators and their levels
+func (s Service) ListModerators(r *http.Request, args *commentapi.ListModeratorsArgs, reply *commentapi.ModeratorsResponse) error {
+	return listModerators(r, args, reply)
+}
+
+// ManageSpammer adds or removes a channel from the comment or reaction spam list, it requires a level 3 global moderator
+func (s Service) ManageSpammer(r *http.Request, args *commentapi.ManageSpammerArgs, reply *commentapi.ManageSpammerResponse) error {
+	return manageSpammer(r, args, reply)
+}
@@ -3,12 +3,15 @@ package moderation
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
 	"github.com/lbryio/commentron/commentapi"
 	"github.com/lbryio/commentron/db"
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/apikeys"
 	"github.com/lbryio/commentron/server/lbry"
+	"github.com/lbryio/commentron/server/moderators"
 
 	"github.com/lbryio/lbry.go/extras/api"
 	"github.com/lbryio/lbry.go/extras/util"
@@ -17,13 +20,18 @@ import (
 	"github.com/volatiletech/null"
 )
 
-func unBlock(_ *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.UnBlockResponse) error {
+func unBlock(r *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.UnBlockResponse) error {
 
-	modChannel, creatorChannel, err := getModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName)
+	// blocks cover all the claims of the creator, so delegates scoped to some claims cannot unblock
+	modChannel, creatorChannel, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, "", commentapi.PermissionBlock)
 	if err != nil {
 		return err
 	}
-	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, args.ModChannelName)
+	digest := lbry.Call("moderation.UnBlock", args.ModChannelName, args.UnBlockedChannelID, args.CreatorChannelID, strconv.FormatBool(args.GlobalUnBlock))
+	if args.ClaimID != "" {
+		digest.Fields = append(digest.Fields, args.ClaimID)
+	}
+	err = apikeys.ValidateSignature(r, apikeys.ScopeModeration, modChannel.ClaimID, args.Signature, args.SigningTS, digest)
 	if err != nil {
 		return err
 	}
@@ -38,12 +46,12 @@ func unBlock(_ *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.Un
 		return errors.Err(err)
 	}
 
-	isMod, err := modChannel.ModChannelModerators().Exists(db.RO)
+	modLevel, err := moderators.Level(modChannel.ClaimID)
 	if err != nil {
-		return errors.Err(err)
+		return err
 	}
 
-	if !isMod && args.GlobalUnBlock {
+	if modLevel < commentapi.ModLevelBlock && args.GlobalUnBlock {
 		return api.StatusError{Err: errors.Err("you must be a global moderator to take global action"), Status: http.StatusBadRequest}
 
 	}
@@ -57,6 +65,10 @@ func unBlock(_ *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.Un
 		if err != nil {
 			return errors.Err(err)
 		}
+		err = recordUnblocks(entries, bannedChannel, modChannel, args.Reason, apikeys.KeyName(r, apikeys.ScopeModeration))
+		if err != nil {
+			return err
+		}
 	} else {
 		if len(entries) > 0 {
 			for _, be := range entries {
@@ -65,6 +77,10 @@ func unBlock(_ *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.Un
 					if err != nil {
 						return errors.Err(err)
 					}
+					err = recordUnblocks(model.BlockedEntrySlice{be}, bannedChannel, modChannel, args.Reason, apikeys.KeyName(r, apikeys.ScopeModeration))
+					if err != nil {
+						return err
+					}
 					reply.UnBlockedFrom = util.PtrToString(creatorChannel.ClaimID)
 				}
 			}
@@ -76,3 +92,32 @@ func unBlock(_ *http.Request, args *commentapi.UnBlockArgs, reply *commentapi.Un
 
 	return nil
 }
+
+// recordUnblocks adds the removed blocks to the change log and the moderation audit log, with the name of the api key
+// that made the call if any
+func recordUnblocks(entries model.BlockedEntrySlice, unblocked, modChannel *model.Channel, reason, apiKey string) error {
+	for _, entry := range entries {
+		event := blockEvent(entry, unblocked, modChannel)
+		err := helper.RecordChange(db.RW, commentapi.ChangeUnblock, nil, unblocked.ClaimID, event)
+		if err != nil {
+			return err
+		}
+		audit := helper.Audit{
+			Action:           commentapi.ActionUnblock,
+			ActorChannelID:   modChannel.ClaimID,
+			CreatorChannelID: entry.CreatorChannelID.String,
+			TargetChannelID:  unblocked.ClaimID,
+			Before:           event,
+			Reason:           reason,
+			APIKey:           apiKey,
+		}
+		if entry.UniversallyBlocked.Bool {
+			audit.CreatorChannelID = ""
+		}
+		err = helper.RecordAction(db.RW, audit)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
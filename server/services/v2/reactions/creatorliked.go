@@ -0,0 +1,40 @@
+package reactions
+
+import (
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/sockety"
+
+	"github.com/lbryio/errors.go"
+	"github.com/lbryio/sockety/socketyapi"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// isCreatorLike returns whether the reaction of the channel is the creator of the claim liking the comment
+func isCreatorLike(reactionType string, channelID, creatorChannelID string) bool {
+	return reactionType == helper.LikeReaction && creatorChannelID != "" && channelID == creatorChannelID
+}
+
+// setCreatorLiked records the creator channel that liked the comment, or that it no longer does if likedBy is not
+// valid. It returns the push of the change to the clients watching the comment, to be sent once it is committed.
+func setCreatorLiked(exec boil.Executor, comment *model.Comment, likedBy null.String) (func(), error) {
+	comment.CreatorLikedBy = likedBy
+	err := comment.Update(exec, boil.Whitelist(model.CommentColumns.CreatorLikedBy))
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	return func() {
+		sockety.SendNotification(socketyapi.SendNotificationArgs{
+			Service: socketyapi.Commentron,
+			Type:    "creator_liked",
+			IDs:     []string{comment.CommentID, comment.LbryClaimID, "reactions"},
+			Data: map[string]interface{}{
+				"claim_id":         comment.LbryClaimID,
+				"comment_id":       comment.CommentID,
+				"creator_liked":    likedBy.Valid,
+				"creator_liked_by": likedBy.String},
+		})
+	}, nil
+}
@@ -0,0 +1,39 @@
+package reactions
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/lbryio/errors.go"
+	"github.com/lbryio/lbry.go/extras/api"
+
+	"github.com/Avalanche-io/counter"
+	"github.com/karlseguin/ccache"
+)
+
+// reactionRateWindow is the window the reactions of a channel are counted in
+const reactionRateWindow = time.Minute
+
+// maxReactionsPerWindow is how many comments a channel can react to in the window
+const maxReactionsPerWindow = 120
+
+var reactionRateCache = ccache.New(ccache.Configure().MaxSize(10000))
+
+// checkReactionRate counts the reactions against the rate limit of the channel, rejecting them all if it would go over
+func checkReactionRate(channelID string, reactions int) error {
+	result, err := reactionRateCache.Fetch(channelID, reactionRateWindow, func() (interface{}, error) {
+		return counter.New(), nil
+	})
+	if err != nil {
+		return errors.Err(err)
+	}
+	channelCounter, ok := result.Value().(*counter.Counter)
+	if !ok {
+		return errors.Err("could not convert counter from cache!")
+	}
+	if channelCounter.Get()+int64(reactions) > maxReactionsPerWindow {
+		return api.StatusError{Err: errors.Err("too many reactions, please wait a minute before reacting again"), Status: http.StatusTooManyRequests}
+	}
+	channelCounter.Add(int64(reactions))
+	return nil
+}
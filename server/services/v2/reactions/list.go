@@ -34,9 +34,8 @@ func list(_ *http.Request, args *commentapi.ReactionListArgs, reply *commentapi.
 	var myfilters = []qm.QueryMod{qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...),
 		qm.Load("ReactionType"),
 		qm.Load("Comment")}
-	var allfilters = []qm.QueryMod{qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...),
-		qm.Load("ReactionType"),
-		qm.Load("Comment")}
+	var countFilters = []qm.QueryMod{qm.WhereIn(model.CommentReactionCountColumns.CommentID+" IN ?", commentIDs...),
+		qm.Load("ReactionType")}
 	if args.Types != nil {
 		typeNames := util.StringSplitArg(util.StrFromPtr(args.Types), ",")
 		types, err := model.ReactionTypes(qm.WhereIn(model.ReactionTypeColumns.Name+" IN ?", typeNames...)).All(db.RO)
@@ -51,7 +50,7 @@ func list(_ *http.Request, args *commentapi.ReactionListArgs, reply *commentapi.
 			return errors.Err("none of the types %s are in use in commentron", util.StrFromPtr(args.Types))
 		}
 		myfilters = append(myfilters, qm.WhereIn(model.ReactionColumns.ReactionTypeID+" IN ?", typeIDs...))
-		allfilters = append(allfilters, qm.WhereIn(model.ReactionColumns.ReactionTypeID+" IN ?", typeIDs...))
+		countFilters = append(countFilters, qm.WhereIn(model.CommentReactionCountColumns.ReactionTypeID+" IN ?", typeIDs...))
 	}
 	channel, err := model.Channels(model.ChannelWhere.ClaimID.EQ(util.StrFromPtr(args.ChannelID))).One(db.RO)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -70,9 +69,8 @@ func list(_ *http.Request, args *commentapi.ReactionListArgs, reply *commentapi.
 	}
 	var userReactions commentapi.Reactions
 	if args.ChannelName != nil {
-		chanErr := lbry.ValidateSignature(util.StrFromPtr(args.ChannelID), args.Signature, args.SigningTS, util.StrFromPtr(args.ChannelName))
+		chanErr := lbry.ValidateSignature(util.StrFromPtr(args.ChannelID), args.Signature, args.SigningTS, lbry.ReadOnly("reaction.List", util.StrFromPtr(args.ChannelName), util.StrFromPtr(args.ChannelID)))
 		if chanErr == nil {
-			allfilters = append(allfilters, qm.Where(model.ReactionColumns.ChannelID+" != ?", channel.ClaimID))
 			reactionlist, err := channel.Reactions(myfilters...).All(db.RO)
 			if err != nil {
 				return errors.Err(err)
@@ -84,13 +82,21 @@ func list(_ *http.Request, args *commentapi.ReactionListArgs, reply *commentapi.
 		}
 	}
 
-	reactionlist, err := model.Reactions(allfilters...).All(db.RO)
+	counts, err := model.CommentReactionCounts(countFilters...).All(db.RO)
 	if err != nil {
 		return errors.Err(err)
 	}
 	var othersReactions = newReactions(strings.Split(args.CommentIDs, ","), args.Types)
-	for _, r := range reactionlist {
-		addTo(othersReactions[r.CommentID], r.R.ReactionType.Name)
+	for _, c := range counts {
+		typeName := c.R.ReactionType.Name
+		others := c.Count
+		if userReactions != nil {
+			others -= userReactions[c.CommentID][typeName]
+		}
+		if othersReactions[c.CommentID] == nil {
+			othersReactions[c.CommentID] = make(commentapi.CommentReaction)
+		}
+		othersReactions[c.CommentID][typeName] = others
 	}
 	reply.MyReactions = userReactions
 	reply.OthersReactions = othersReactions
@@ -129,9 +135,12 @@ func addTo(c commentapi.CommentReaction, reactionType string) {
 
 var reactionTypeCache = ccache.New(ccache.Configure().MaxSize(100))
 
+const globalReactionTypesKey = "global"
+
+// getReactionTypes returns the reaction types on the global allowlist
 func getReactionTypes() (model.ReactionTypeSlice, error) {
-	v, err := reactionTypeCache.Fetch("all", 30*time.Minute, func() (interface{}, error) {
-		rts, err := model.ReactionTypes().All(db.RO)
+	v, err := reactionTypeCache.Fetch(globalReactionTypesKey, 30*time.Minute, func() (interface{}, error) {
+		rts, err := model.ReactionTypes(model.ReactionTypeWhere.IsGlobal.EQ(true), qm.OrderBy(model.ReactionTypeColumns.Name)).All(db.RO)
 		if err != nil {
 			return nil, errors.Err(err)
 		}
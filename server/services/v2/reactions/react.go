@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/config"
 	"github.com/lbryio/commentron/db"
 	"github.com/lbryio/commentron/flags"
 	"github.com/lbryio/commentron/helper"
 	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
 	"github.com/lbryio/commentron/server/lbry"
 	"github.com/lbryio/commentron/sockety"
 
@@ -20,7 +24,6 @@ import (
 	"github.com/lbryio/lbry.go/v2/extras/util"
 	"github.com/lbryio/sockety/socketyapi"
 
-	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
 	"github.com/volatiletech/sqlboiler/queries/qm"
@@ -28,8 +31,11 @@ import (
 
 // React creates/updates a reaction to a comment
 func react(r *http.Request, args *commentapi.ReactArgs, reply *commentapi.ReactResponse) error {
-
-	comments, err := model.Comments(qm.WhereIn(model.CommentColumns.CommentID+" IN ?", util.StringSplitArg(args.CommentIDs, ",")...)).All(db.RO)
+	requestedIDs := util.StringSplitArg(args.CommentIDs, ",")
+	if len(requestedIDs) > commentapi.MaxReactCommentIDs {
+		return api.StatusError{Err: errors.Err("at most %d comments can be reacted to in one call", commentapi.MaxReactCommentIDs), Status: http.StatusBadRequest}
+	}
+	comments, err := model.Comments(qm.WhereIn(model.CommentColumns.CommentID+" IN ?", requestedIDs...)).All(db.RO)
 	if err != nil {
 		return errors.Err(err)
 	}
@@ -37,11 +43,15 @@ func react(r *http.Request, args *commentapi.ReactArgs, reply *commentapi.ReactR
 		return errors.Err("could not find comments(s)")
 	}
 	var commentIDs []interface{}
+	found := make(map[string]bool, len(comments))
 	for _, p := range comments {
 		commentIDs = append(commentIDs, p.CommentID)
+		found[p.CommentID] = true
 	}
-	if len(commentIDs) > 1 {
-		return api.StatusError{Err: errors.Err("only one comment id can be passed currently"), Status: http.StatusBadRequest}
+	for _, id := range requestedIDs {
+		if !found[id.(string)] {
+			return api.StatusError{Err: errors.Err("could not find comment %s", id), Status: http.StatusBadRequest}
+		}
 	}
 	channel, err := model.Channels(model.ChannelWhere.ClaimID.EQ(args.ChannelID)).One(db.RO)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -55,24 +65,47 @@ func react(r *http.Request, args *commentapi.ReactArgs, reply *commentapi.ReactR
 			return errors.Err(err)
 		}
 	}
-	err = lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, args.ChannelName)
+	err = lbry.ValidateSignature(args.ChannelID, args.Signature, args.SigningTS, lbry.Call("reaction.React", args.ChannelName,
+		args.CommentIDs, args.Type, strconv.FormatBool(args.Remove), args.ClearTypes))
 	if err != nil {
 		return errors.Prefix("could not authenticate channel signature:", err)
 	}
-	if len(comments) > 1 {
-		logrus.Warningf("%d comments reacted to in the same call from ip[%s] for channel %s[%s]", len(comments), helper.GetIPAddressForRequest(r), channel.Name, channel.ClaimID)
+	err = checkReactionRate(channel.ClaimID, len(comments))
+	if err != nil {
+		return err
 	}
 
-	modifiedReactions, err := updateReactions(channel, args, commentIDs, comments)
+	modifiedReactions, results, err := updateReactions(channel, args, commentIDs, comments)
 	if err != nil {
 		return errors.Err(err)
 	}
 	reply.Reactions = modifiedReactions
+	reply.Results = results
 	return nil
 }
-func updateReactions(channel *model.Channel, args *commentapi.ReactArgs, commentIDs []interface{}, comments model.CommentSlice) (commentapi.Reactions, error) {
+
+func updateReactions(channel *model.Channel, args *commentapi.ReactArgs, commentIDs []interface{}, comments model.CommentSlice) (commentapi.Reactions, map[string]string, error) {
 	var modifiedReactions = newReactions(strings.Split(args.CommentIDs, ","), &args.Type)
+	results := make(map[string]string, len(comments))
+	for _, c := range comments {
+		results[c.CommentID] = commentapi.ReactionUnchanged
+	}
+	var pushes []func()
+	// unlike clears the creator liked flag of the comment if the reaction removed was the creator liking it
+	unlike := func(exec boil.Executor, comment *model.Comment, typeName string, channelID null.String) error {
+		if !isCreatorLike(typeName, channelID.String, comment.CreatorLikedBy.String) {
+			return nil
+		}
+		push, err := setCreatorLiked(exec, comment, null.String{})
+		if err != nil {
+			return err
+		}
+		pushes = append(pushes, push)
+		return nil
+	}
 	err := db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		// comments whose likes or dislikes changed and need to be scored again
+		rescore := make(map[string]*model.Comment)
 		if len(args.ClearTypes) > 0 {
 			typeNames := util.StringSplitArg(args.ClearTypes, ",")
 			reactionTypes, err := model.ReactionTypes(qm.WhereIn(model.ReactionTypeColumns.Name+" IN ?", typeNames...)).All(tx)
@@ -81,31 +114,55 @@ func updateReactions(channel *model.Channel, args *commentapi.ReactArgs, comment
 			}
 			if len(reactionTypes) > 0 {
 				var typesToClear []interface{}
+				typeNames := make(map[uint64]string)
 				for _, rt := range reactionTypes {
 					typesToClear = append(typesToClear, rt.ID)
+					typeNames[rt.ID] = rt.Name
 				}
-				err = channel.Reactions(
+				cleared, err := channel.Reactions(
 					qm.Where(model.ReactionColumns.ChannelID+"=?", channel.ClaimID),
 					qm.WhereIn(model.ReactionColumns.ReactionTypeID+" IN ?", typesToClear...),
-					qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...)).DeleteAll(tx)
+					qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...),
+					qm.Load("Comment")).All(tx)
+				if err != nil {
+					return errors.Err(err)
+				}
+				err = cleared.DeleteAll(tx)
 				if err != nil {
 					return errors.Err(err)
 				}
+				for _, r := range cleared {
+					comment, typeName := r.R.Comment, typeNames[r.ReactionTypeID]
+					err := helper.AddReactions(tx, r.CommentID, r.ReactionTypeID, -1)
+					if err != nil {
+						return err
+					}
+					if isScored(typeName) {
+						rescore[comment.CommentID] = comment
+					}
+					err = unlike(tx, comment, typeName, r.ChannelID)
+					if err != nil {
+						return err
+					}
+					err = helper.RecordChange(tx, commentapi.ChangeReaction, comment, channel.ClaimID, commentapi.ReactionChange{ReactionType: typeName, Delta: -1})
+					if err != nil {
+						return err
+					}
+					err = rollup.Reaction(tx, comment, typeName, -1)
+					if err != nil {
+						return err
+					}
+				}
 			}
 		}
 
-		reactionType, err := model.ReactionTypes(model.ReactionTypeWhere.Name.EQ(args.Type)).One(tx)
-		if errors.Is(err, sql.ErrNoRows) {
-			err = nil
-			reactionType = &model.ReactionType{Name: args.Type}
-			err = reactionType.Insert(tx, boil.Infer())
-		}
+		reactionType, err := helper.FindReactionType(tx, args.Type)
 		if err != nil {
-			return errors.Err(err)
+			return err
 		}
 		if args.Remove {
 			existingReactions, err := channel.Reactions(
-				qm.WhereIn(model.ReactionColumns.CommentID+"=?", commentIDs...),
+				qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...),
 				qm.Where(model.ReactionColumns.ReactionTypeID+"=?", reactionType.ID),
 				qm.Load("Comment")).All(tx)
 			if err != nil {
@@ -115,19 +172,66 @@ func updateReactions(channel *model.Channel, args *commentapi.ReactArgs, comment
 				return api.StatusError{Err: errors.Err("there are no reactions for the claim(s) to remove"), Status: http.StatusBadRequest}
 			}
 			for _, r := range existingReactions {
-				go updateCommentScoring(reactionType, r.R.Comment)
-				addTo(modifiedReactions[r.R.Comment.CommentID], args.Type)
+				comment := r.R.Comment
+				err := helper.AddReactions(tx, r.CommentID, r.ReactionTypeID, -1)
+				if err != nil {
+					return err
+				}
+				if isScored(reactionType.Name) {
+					rescore[comment.CommentID] = comment
+				}
+				err = unlike(tx, comment, reactionType.Name, r.ChannelID)
+				if err != nil {
+					return err
+				}
+				err = helper.RecordChange(tx, commentapi.ChangeReaction, comment, channel.ClaimID, commentapi.ReactionChange{ReactionType: reactionType.Name, Delta: -1})
+				if err != nil {
+					return err
+				}
+				addTo(modifiedReactions[comment.CommentID], args.Type)
+				results[comment.CommentID] = commentapi.ReactionRemoved
+				err = rollup.Reaction(tx, comment, reactionType.Name, -1)
+				if err != nil {
+					return err
+				}
 			}
 			err = existingReactions.DeleteAll(tx)
+			if err != nil {
+				return errors.Err(err)
+			}
+			return updateScoring(tx, rescore)
+		}
+		existingReactions, err := channel.Reactions(
+			qm.WhereIn(model.ReactionColumns.CommentID+" IN ?", commentIDs...),
+			qm.Where(model.ReactionColumns.ReactionTypeID+"=?", reactionType.ID)).All(tx)
+		if err != nil {
 			return errors.Err(err)
 		}
+		alreadyReacted := make(map[string]bool, len(existingReactions))
+		for _, r := range existingReactions {
+			alreadyReacted[r.CommentID] = true
+		}
+		if len(alreadyReacted) == len(comments) {
+			return api.StatusError{Err: errors.Err("reaction already acknowledged!"), Status: http.StatusBadRequest}
+		}
 		for _, p := range comments {
+			if alreadyReacted[p.CommentID] {
+				continue
+			}
 			err = helper.AllowedToRespond(p.CommentID, channel.ClaimID)
 			if err != nil {
 				return err
 			}
-			newReaction := &model.Reaction{ChannelID: null.StringFrom(channel.ClaimID), CommentID: p.CommentID, ReactionTypeID: reactionType.ID, ClaimID: p.LbryClaimID, IsFlagged: len(comments) > 1}
-			err := flags.CheckReaction(newReaction)
+			creatorChannelID, err := creatorOf(p)
+			if err != nil {
+				return err
+			}
+			err = helper.AllowedReactionType(tx, reactionType, creatorChannelID)
+			if err != nil {
+				return err
+			}
+			newReaction := &model.Reaction{ChannelID: null.StringFrom(channel.ClaimID), CommentID: p.CommentID, ReactionTypeID: reactionType.ID, ClaimID: p.LbryClaimID}
+			err = flags.CheckReaction(newReaction)
 			if err != nil {
 				return err
 			}
@@ -138,51 +242,108 @@ func updateReactions(channel *model.Channel, args *commentapi.ReactArgs, comment
 				}
 				return errors.Err(err)
 			}
-			go updateCommentScoring(reactionType, p)
+			err = helper.AddReactions(tx, p.CommentID, reactionType.ID, 1)
+			if err != nil {
+				return err
+			}
+			err = helper.RecordChange(tx, commentapi.ChangeReaction, p, channel.ClaimID, commentapi.ReactionChange{ReactionType: reactionType.Name, Delta: 1})
+			if err != nil {
+				return err
+			}
+			if isScored(reactionType.Name) {
+				rescore[p.CommentID] = p
+			}
+			if isCreatorLike(reactionType.Name, channel.ClaimID, creatorChannelID) {
+				push, err := setCreatorLiked(tx, p, null.StringFrom(channel.ClaimID))
+				if err != nil {
+					return err
+				}
+				pushes = append(pushes, push)
+			}
 			addTo(modifiedReactions[p.CommentID], reactionType.Name)
-			go sockety.SendNotification(socketyapi.SendNotificationArgs{
-				Service: socketyapi.Commentron,
-				Type:    "reaction",
-				IDs:     []string{p.CommentID, p.LbryClaimID, "reactions"},
-				Data: map[string]interface{}{
-					"commenter_channel_id": p.ChannelID.String,
-					"claim_id":             p.LbryClaimID,
-					"comment_id":           p.CommentID,
-					"reaction_type":        reactionType.Name},
+			results[p.CommentID] = commentapi.ReactionAdded
+			err = rollup.Reaction(tx, p, reactionType.Name, 1)
+			if err != nil {
+				return err
+			}
+			comment := p
+			pushes = append(pushes, func() {
+				sockety.SendNotification(socketyapi.SendNotificationArgs{
+					Service: socketyapi.Commentron,
+					Type:    "reaction",
+					IDs:     []string{comment.CommentID, comment.LbryClaimID, "reactions"},
+					Data: map[string]interface{}{
+						"commenter_channel_id": comment.ChannelID.String,
+						"claim_id":             comment.LbryClaimID,
+						"comment_id":           comment.CommentID,
+						"reaction_type":        reactionType.Name},
+				})
 			})
 		}
-		return nil
+		return updateScoring(tx, rescore)
 	})
 	if err != nil {
-		return nil, errors.Err(err)
+		return nil, nil, errors.Err(err)
 	}
-	return modifiedReactions, nil
+	go func() {
+		for _, p := range pushes {
+			p()
+		}
+	}()
+	return modifiedReactions, results, nil
 }
 
-const likeRT = uint64(4)
-const disLikeRT = uint64(8)
+func isScored(reactionType string) bool {
+	return config.ReactionScore(reactionType) != 0
+}
 
-func updateCommentScoring(reactionType *model.ReactionType, comment *model.Comment) {
-	if reactionType.ID != likeRT && reactionType.ID != disLikeRT {
-		return
+// creatorOf returns the channel of the creator of the claim the comment was made on, empty if it is not signed
+func creatorOf(comment *model.Comment) (string, error) {
+	if comment.CreatorChannelID.Valid {
+		return comment.CreatorChannelID.String, nil
 	}
-	// Update Popularity Score
-	likes, err := comment.Reactions(model.ReactionWhere.ReactionTypeID.EQ(likeRT)).Count(db.RO)
+	channel, err := lbry.SDK.GetSigningChannelForClaim(comment.LbryClaimID)
 	if err != nil {
-		logrus.Error(errors.Prefix(fmt.Sprintf("Error getting comment[%s] likes:", comment.CommentID), err))
-		return
+		return "", errors.Err(err)
 	}
-	comment.PopularityScore.SetValid(int(likes))
-	err = comment.Update(db.RW, boil.Whitelist(model.CommentColumns.PopularityScore))
-	if err != nil {
-		logrus.Error(errors.Prefix(fmt.Sprintf("Error updating comment[%s] popularity scoring:", comment.CommentID), err))
+	if channel == nil {
+		return "", nil
 	}
-	// Update Controversy Score
-	dislikes, err := comment.Reactions(model.ReactionWhere.ReactionTypeID.EQ(disLikeRT)).Count(db.RO)
+	return channel.ClaimID, nil
+}
+
+func updateScoring(tx boil.Transactor, comments map[string]*model.Comment) error {
+	for _, c := range comments {
+		err := updateCommentScoring(tx, c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateCommentScoring sets the scores of the comment from the counters of its positive and negative reactions
+func updateCommentScoring(exec boil.Executor, comment *model.Comment) error {
+	counts, err := model.CommentReactionCounts(
+		model.CommentReactionCountWhere.CommentID.EQ(comment.CommentID),
+		qm.Load(model.CommentReactionCountRels.ReactionType)).All(exec)
 	if err != nil {
-		logrus.Error(errors.Prefix(fmt.Sprintf("Error getting comment[%s] dislikes:", comment.CommentID), err))
-		return
+		return errors.Prefix(fmt.Sprintf("Error getting comment[%s] likes and dislikes:", comment.CommentID), err)
 	}
+	var likes, dislikes int
+	for _, c := range counts {
+		switch config.ReactionScore(c.R.ReactionType.Name) {
+		case 1:
+			likes += c.Count
+		case -1:
+			dislikes += c.Count
+		}
+	}
+	// Update Popularity Score
+	comment.PopularityScore.SetValid(likes)
+	comment.HotScore = helper.HotScore(likes, dislikes, time.Unix(int64(comment.Timestamp), 0), time.Now())
+	comment.BestScore = helper.BestScore(likes, dislikes)
+	// Update Controversy Score
 	absValue := math.Abs(float64(likes - dislikes))
 	if absValue == 0 {
 		absValue = 1
@@ -190,8 +351,10 @@ func updateCommentScoring(reactionType *model.ReactionType, comment *model.Comme
 	//IF(ABS(likes-dislikes) = 0, 1-(1/(likes+dislikes+1)*10000, ABS(likes-dislikes))/(likes+dislikes+1)*10000
 	score := (1 - absValue/float64(likes+dislikes+1)) * 10000
 	comment.ControversyScore.SetValid(int(score))
-	err = comment.Update(db.RW, boil.Whitelist(model.CommentColumns.ControversyScore))
+	err = comment.Update(exec, boil.Whitelist(model.CommentColumns.PopularityScore, model.CommentColumns.ControversyScore,
+		model.CommentColumns.HotScore, model.CommentColumns.BestScore))
 	if err != nil {
-		logrus.Error(errors.Prefix(fmt.Sprintf("Error updating comment[%s] controversy scoring:", comment.CommentID), err))
+		return errors.Prefix(fmt.Sprintf("Error updating comment[%s] scoring:", comment.CommentID), err)
 	}
+	return nil
 }
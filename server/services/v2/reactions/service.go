@@ -18,3 +18,13 @@ func (s Service) List(r *http.Request, args *commentapi.ReactionListArgs, reply
 func (s Service) React(r *http.Request, args *commentapi.ReactArgs, reply *commentapi.ReactResponse) error {
 	return react(r, args, reply)
 }
+
+// Types returns the reaction types allowed everywhere and the ones a creator enabled on their content
+func (s Service) Types(r *http.Request, args *commentapi.ReactionTypesArgs, reply *commentapi.ReactionTypesResponse) error {
+	return types(r, args, reply)
+}
+
+// ManageType adds a reaction type to the global allowlist or takes it off
+func (s Service) ManageType(r *http.Request, args *commentapi.ManageReactionTypeArgs, reply *commentapi.ManageReactionTypeResponse) error {
+	return manageType(r, args, reply)
+}
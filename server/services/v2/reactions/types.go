@@ -0,0 +1,94 @@
+package reactions
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/config"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/errors.go"
+	"github.com/lbryio/lbry.go/extras/api"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+func types(_ *http.Request, args *commentapi.ReactionTypesArgs, reply *commentapi.ReactionTypesResponse) error {
+	global, err := getReactionTypes()
+	if err != nil {
+		return err
+	}
+	reply.Global = populateReactionTypes(global)
+	if args.CreatorChannelID != nil {
+		creatorChannel := &model.Channel{ClaimID: *args.CreatorChannelID}
+		enabled, err := creatorChannel.ReactionTypes(
+			model.ReactionTypeWhere.IsGlobal.EQ(false),
+			qm.OrderBy(model.ReactionTypeColumns.Name)).All(db.RO)
+		if err != nil {
+			return errors.Err(err)
+		}
+		reply.Creator = populateReactionTypes(enabled)
+	}
+	return nil
+}
+
+func populateReactionTypes(reactionTypes model.ReactionTypeSlice) []commentapi.ReactionType {
+	items := make([]commentapi.ReactionType, len(reactionTypes))
+	for i, rt := range reactionTypes {
+		items[i] = commentapi.ReactionType{Name: rt.Name, Score: config.ReactionScore(rt.Name)}
+	}
+	return items
+}
+
+func manageType(_ *http.Request, args *commentapi.ManageReactionTypeArgs, reply *commentapi.ManageReactionTypeResponse) error {
+	modChannel, err := helper.FindOrCreateChannel(args.ModChannelID, args.ModChannelName)
+	if err != nil {
+		return err
+	}
+	err = lbry.ValidateSignature(modChannel.ClaimID, args.Signature, args.SigningTS, lbry.Call("reaction.ManageType", "", args.Name, strconv.FormatBool(args.Remove)))
+	if err != nil {
+		return err
+	}
+	isMod, err := modChannel.ModChannelModerators().Exists(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if !isMod {
+		return api.StatusError{Err: errors.Err("only global moderators can manage reaction types"), Status: http.StatusForbidden}
+	}
+
+	reactionType, err := model.ReactionTypes(model.ReactionTypeWhere.Name.EQ(args.Name)).One(db.RO)
+	if errors.Is(err, sql.ErrNoRows) && !args.Remove {
+		reactionType = &model.ReactionType{Name: args.Name, IsGlobal: true}
+		err = reactionType.Insert(db.RW, boil.Infer())
+		if err != nil {
+			return errors.Err(err)
+		}
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return api.StatusError{Err: errors.Err("'%s' is not a known reaction type", args.Name), Status: http.StatusBadRequest}
+	} else if err != nil {
+		return errors.Err(err)
+	} else {
+		reactionType.IsGlobal = !args.Remove
+		err = reactionType.Update(db.RW, boil.Whitelist(model.ReactionTypeColumns.IsGlobal))
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	reactionTypeCache.Delete(globalReactionTypesKey)
+
+	reply.Name = reactionType.Name
+	reply.Score = config.ReactionScore(reactionType.Name)
+	reply.Global = reactionType.IsGlobal
+	return helper.RecordAction(db.RW, helper.Audit{
+		Action:         commentapi.ActionManageReactionType,
+		ActorChannelID: modChannel.ClaimID,
+		After:          reply,
+	})
+}
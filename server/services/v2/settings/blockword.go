@@ -21,11 +21,8 @@ func (s *Service) BlockWord(r *http.Request, args *commentapi.BlockWordArgs, rep
 	if len(args.Words) == 0 {
 		return api.StatusError{Err: errors.Err("words to block %s must exist", args.Words)}
 	}
-	creatorChannel, err := helper.FindOrCreateChannel(args.ChannelID, args.ChannelName)
-	if err != nil {
-		return errors.Err(err)
-	}
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	signer, creatorChannel, err := lbry.ValidateDelegation(args.ChannelID, args.ChannelName, args.ModChannelID, args.ModChannelName, commentapi.PermissionMutedWords,
+		args.Signature, args.SigningTS, lbry.Call("setting.BlockWord", args.ChannelName, args.ChannelID, args.Words))
 	if err != nil {
 		return err
 	}
@@ -38,6 +35,7 @@ func (s *Service) BlockWord(r *http.Request, args *commentapi.BlockWordArgs, rep
 	if !settings.MutedWords.IsZero() {
 		existingWords = strings.Split(settings.MutedWords.String, ",")
 	}
+	previousWords := existingWords
 	wordsToAdd := strings.Split(args.Words, ",")
 
 	existingWords = append(existingWords, wordsToAdd...)
@@ -46,20 +44,24 @@ func (s *Service) BlockWord(r *http.Request, args *commentapi.BlockWordArgs, rep
 	if err != nil {
 		return errors.Err(err)
 	}
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionBlockWords,
+		ActorChannelID:   signer.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		Before:           previousWords,
+		After:            existingWords,
+	})
+	if err != nil {
+		return err
+	}
 	reply.WordList = existingWords
 	return nil
 }
 
 // UnBlockWord takes a list of words to remove from the list of blocked words if they exist.
 func (s *Service) UnBlockWord(r *http.Request, args *commentapi.UnBlockWordArgs, reply *commentapi.BlockWordRespose) error {
-	creatorChannel, err := helper.FindOrCreateChannel(args.ChannelID, args.ChannelName)
-	if err != nil {
-		return errors.Err(err)
-	}
-	if creatorChannel == nil {
-		return api.StatusError{Err: errors.Err("could not find channel %s with channel id %s", args.ChannelName, args.ChannelID), Status: http.StatusBadRequest}
-	}
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	signer, creatorChannel, err := lbry.ValidateDelegation(args.ChannelID, args.ChannelName, args.ModChannelID, args.ModChannelName, commentapi.PermissionMutedWords,
+		args.Signature, args.SigningTS, lbry.Call("setting.UnBlockWord", args.ChannelName, args.ChannelID, args.Words))
 	if err != nil {
 		return err
 	}
@@ -89,6 +91,16 @@ skip:
 	if err != nil {
 		return errors.Err(err)
 	}
+	err = helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionUnblockWords,
+		ActorChannelID:   signer.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		Before:           existingWords,
+		After:            remainingWords,
+	})
+	if err != nil {
+		return err
+	}
 	reply.WordList = remainingWords
 	return nil
 }
@@ -99,7 +111,7 @@ func (s *Service) ListBlockedWords(r *http.Request, args *commentapi.ListBlocked
 	if err != nil {
 		return errors.Err(err)
 	}
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("setting.ListBlockedWords", args.ChannelName, args.ChannelID))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,72 @@
+package settings
+
+import (
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/websocket"
+	"github.com/lbryio/commentron/sockety"
+
+	"github.com/lbryio/sockety/socketyapi"
+)
+
+// applyChatModes applies the live chat modes passed and returns whether any of them changed
+func applyChatModes(settings *model.CreatorSetting, args *commentapi.UpdateSettingsArgs) bool {
+	var changed bool
+	if args.SlowModeMinGap != nil {
+		settings.SlowModeMinGap.SetValid(*args.SlowModeMinGap)
+		if *args.SlowModeMinGap == 0 {
+			settings.SlowModeMinGap.Valid = false
+		}
+		changed = true
+	}
+
+	if args.EmoteOnly != nil {
+		settings.EmoteOnly.SetValid(*args.EmoteOnly)
+		changed = true
+	}
+
+	if args.SupportersOnly != nil {
+		settings.SupportersOnly.SetValid(*args.SupportersOnly)
+		changed = true
+	}
+
+	if args.TimeSinceFirstComment != nil {
+		settings.TimeSinceFirstComment.SetValid(*args.TimeSinceFirstComment)
+		if *args.TimeSinceFirstComment == 0 {
+			settings.TimeSinceFirstComment.Valid = false
+		}
+		changed = true
+	}
+	return changed
+}
+
+// pushChatModes lets live chats know the chat modes changed. It is sent to the creator's channel and optionally
+// to the live chat claim passed by the creator.
+func pushChatModes(creatorChannel *model.Channel, settings *model.CreatorSetting, activeClaimID *string) {
+	modes := map[string]interface{}{
+		"channel_id":               creatorChannel.ClaimID,
+		"slow_mode_min_gap":        settings.SlowModeMinGap.Uint64,
+		"emote_only":               settings.EmoteOnly.Bool,
+		"supporters_only":          settings.SupportersOnly.Bool,
+		"time_since_first_comment": settings.TimeSinceFirstComment.Uint64,
+	}
+	ids := []string{creatorChannel.ClaimID, "settings"}
+	websocket.PushTo(&websocket.PushNotification{
+		Type: "chat_mode",
+		Data: modes,
+	}, creatorChannel.ClaimID)
+	if activeClaimID != nil && *activeClaimID != "" {
+		ids = append(ids, *activeClaimID)
+		websocket.PushTo(&websocket.PushNotification{
+			Type: "chat_mode",
+			Data: modes,
+		}, *activeClaimID)
+	}
+
+	go sockety.SendNotification(socketyapi.SendNotificationArgs{
+		Service: socketyapi.Commentron,
+		Type:    "chat_mode",
+		IDs:     ids,
+		Data:    modes,
+	})
+}
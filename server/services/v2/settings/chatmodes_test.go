@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+)
+
+func TestApplyChatModes(t *testing.T) {
+	yes := true
+	no := false
+	var zero uint64
+	var ten uint64 = 10
+
+	settings := &model.CreatorSetting{}
+	if applyChatModes(settings, &commentapi.UpdateSettingsArgs{}) {
+		t.Error("no chat modes passed but reported as changed")
+	}
+
+	changed := applyChatModes(settings, &commentapi.UpdateSettingsArgs{EmoteOnly: &yes, SupportersOnly: &yes, SlowModeMinGap: &ten, TimeSinceFirstComment: &ten})
+	if !changed {
+		t.Error("chat modes passed but not reported as changed")
+	}
+	if !settings.EmoteOnly.Bool || !settings.SupportersOnly.Bool {
+		t.Error("emote only and supporters only should be on")
+	}
+	if settings.SlowModeMinGap != null.Uint64From(10) || settings.TimeSinceFirstComment != null.Uint64From(10) {
+		t.Errorf("expected a slow mode and first comment age of 10, got %v and %v", settings.SlowModeMinGap, settings.TimeSinceFirstComment)
+	}
+
+	applyChatModes(settings, &commentapi.UpdateSettingsArgs{EmoteOnly: &no, SlowModeMinGap: &zero, TimeSinceFirstComment: &zero})
+	if settings.EmoteOnly.Bool {
+		t.Error("emote only should be off")
+	}
+	if !settings.SupportersOnly.Bool {
+		t.Error("supporters only was not passed and should still be on")
+	}
+	if settings.SlowModeMinGap.Valid || settings.TimeSinceFirstComment.Valid {
+		t.Error("a slow mode and first comment age of 0 should turn them off")
+	}
+}
@@ -0,0 +1,80 @@
+package settings
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/validator"
+
+	"github.com/lbryio/errors.go"
+	"github.com/lbryio/lbry.go/extras/api"
+	v "github.com/lbryio/ozzo-validation"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// maxCreatorReactionTypes is the most reaction types a creator can enable on their content
+const maxCreatorReactionTypes = 50
+
+// maxRegisteredReactionTypes is the most new reaction types a creator can register over all their updates
+const maxRegisteredReactionTypes = 100
+
+func updateReactionTypes(creatorChannel *model.Channel, names []string) error {
+	if len(names) > maxCreatorReactionTypes {
+		return api.StatusError{Err: errors.Err("a creator can enable at most %d reaction types", maxCreatorReactionTypes), Status: http.StatusBadRequest}
+	}
+	for _, name := range names {
+		if err := v.Validate(name, validator.ReactionName); err != nil {
+			return api.StatusError{Err: errors.Err("'%s': %s", name, err), Status: http.StatusBadRequest}
+		}
+	}
+
+	return db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		var reactionTypes model.ReactionTypeSlice
+		registered := int64(-1)
+		enabled := make(map[string]bool)
+		for _, name := range names {
+			if enabled[name] {
+				continue
+			}
+			enabled[name] = true
+			reactionType, err := model.ReactionTypes(model.ReactionTypeWhere.Name.EQ(name)).One(tx)
+			if errors.Is(err, sql.ErrNoRows) {
+				if registered < 0 {
+					registered, err = model.ReactionTypes(model.ReactionTypeWhere.CreatedBy.EQ(null.StringFrom(creatorChannel.ClaimID))).Count(tx)
+					if err != nil {
+						return errors.Err(err)
+					}
+				}
+				if registered >= maxRegisteredReactionTypes {
+					return api.StatusError{Err: errors.Err("a creator can register at most %d new reaction types", maxRegisteredReactionTypes), Status: http.StatusBadRequest}
+				}
+				registered++
+				// A new custom type only usable where creators enabled it
+				reactionType = &model.ReactionType{Name: name, CreatedBy: null.StringFrom(creatorChannel.ClaimID)}
+				err = reactionType.Insert(tx, boil.Infer())
+			}
+			if err != nil {
+				return errors.Err(err)
+			}
+			reactionTypes = append(reactionTypes, reactionType)
+		}
+		return errors.Err(creatorChannel.SetReactionTypes(tx, false, reactionTypes...))
+	})
+}
+
+func applyReactionTypesToReply(creatorChannel *model.Channel, reply *commentapi.ListSettingsResponse) error {
+	reactionTypes, err := creatorChannel.ReactionTypes(qm.OrderBy(model.ReactionTypeColumns.Name)).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, rt := range reactionTypes {
+		reply.ReactionTypes = append(reply.ReactionTypes, rt.Name)
+	}
+	return nil
+}
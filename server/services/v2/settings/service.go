@@ -26,7 +26,7 @@ func (s *Service) List(r *http.Request, args *commentapi.ListSettingsArgs, reply
 	if err != nil {
 		return errors.Err(err)
 	}
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("setting.List", args.ChannelName, args.ChannelID))
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,11 @@ func (s *Service) List(r *http.Request, args *commentapi.ListSettingsArgs, reply
 
 	applySettingsToReply(settings, reply, authorized)
 
-	return nil
+	err = applyTickerTiersToReply(creatorChannel, reply)
+	if err != nil {
+		return err
+	}
+	return applyReactionTypesToReply(creatorChannel, reply)
 }
 
 // Get returns the list of creator settings for users
@@ -57,16 +61,21 @@ func (s *Service) Get(r *http.Request, args *commentapi.ListSettingsArgs, reply
 
 	applySettingsToReply(settings, reply, authorized)
 
-	return nil
+	err = applyTickerTiersToReply(creatorChannel, reply)
+	if err != nil {
+		return err
+	}
+	return applyReactionTypesToReply(creatorChannel, reply)
 }
 
 // Update updates the different settings if passed.
 func (s *Service) Update(r *http.Request, args *commentapi.UpdateSettingsArgs, reply *commentapi.ListSettingsResponse) error {
-	creatorChannel, err := helper.FindOrCreateChannel(args.ChannelID, args.ChannelName)
+	canonical, err := lbry.CanonicalArgs(args)
 	if err != nil {
-		return errors.Err(err)
+		return err
 	}
-	err = lbry.ValidateSignature(creatorChannel.ClaimID, args.Signature, args.SigningTS, args.ChannelName)
+	signer, creatorChannel, err := lbry.ValidateDelegation(args.ChannelID, args.ChannelName, args.ModChannelID, args.ModChannelName, commentapi.PermissionSettings,
+		args.Signature, args.SigningTS, lbry.Call("setting.Update", args.ChannelName, args.ChannelID, canonical))
 	if err != nil {
 		return err
 	}
@@ -77,16 +86,15 @@ func (s *Service) Update(r *http.Request, args *commentapi.UpdateSettingsArgs, r
 		return err
 	}
 
+	var before commentapi.ListSettingsResponse
+	previous := *settings
+	applySettingsToReply(&previous, &before, authorized)
+
 	if args.CommentsEnabled != nil {
 		settings.CommentsEnabled.SetValid(*args.CommentsEnabled)
 	}
 
-	if args.SlowModeMinGap != nil {
-		settings.SlowModeMinGap.SetValid(*args.SlowModeMinGap)
-		if *args.SlowModeMinGap == 0 {
-			settings.SlowModeMinGap.Valid = false
-		}
-	}
+	chatModeChanged := applyChatModes(settings, args)
 
 	if args.MinTipAmountSuperChat != nil {
 		lbc, err := btcutil.NewAmount(*args.MinTipAmountSuperChat)
@@ -121,14 +129,50 @@ func (s *Service) Update(r *http.Request, args *commentapi.UpdateSettingsArgs, r
 		settings.IsFiltersEnabled.SetValid(*args.FiltersEnabled)
 	}
 
+	if args.TickerTiers != nil {
+		settings.TickerDisabled.SetValid(len(*args.TickerTiers) == 0)
+	}
+
 	err = settings.Update(db.RW, boil.Infer())
 	if err != nil {
 		return errors.Err(err)
 	}
 
+	if args.TickerTiers != nil {
+		err = updateTickerTiers(creatorChannel, *args.TickerTiers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if args.ReactionTypes != nil {
+		err = updateReactionTypes(creatorChannel, *args.ReactionTypes)
+		if err != nil {
+			return err
+		}
+	}
+
+	if chatModeChanged {
+		go pushChatModes(creatorChannel, settings, args.ActiveClaimID)
+	}
+
 	applySettingsToReply(settings, reply, authorized)
 
-	return nil
+	err = applyTickerTiersToReply(creatorChannel, reply)
+	if err != nil {
+		return err
+	}
+	err = applyReactionTypesToReply(creatorChannel, reply)
+	if err != nil {
+		return err
+	}
+	return helper.RecordAction(db.RW, helper.Audit{
+		Action:           commentapi.ActionUpdateSettings,
+		ActorChannelID:   signer.ClaimID,
+		CreatorChannelID: creatorChannel.ClaimID,
+		Before:           before,
+		After:            reply,
+	})
 }
 
 func applySettingsToReply(settings *model.CreatorSetting, reply *commentapi.ListSettingsResponse, authorized bool) {
@@ -156,5 +200,14 @@ func applySettingsToReply(settings *model.CreatorSetting, reply *commentapi.List
 	if settings.CurseJarAmount.Valid {
 		reply.CurseJarAmount = util.PtrToUint64(settings.CurseJarAmount.Uint64)
 	}
+	if settings.EmoteOnly.Valid {
+		reply.EmoteOnly = &settings.EmoteOnly.Bool
+	}
+	if settings.SupportersOnly.Valid {
+		reply.SupportersOnly = &settings.SupportersOnly.Bool
+	}
+	if settings.TimeSinceFirstComment.Valid {
+		reply.TimeSinceFirstComment = &settings.TimeSinceFirstComment.Uint64
+	}
 
 }
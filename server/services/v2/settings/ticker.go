@@ -0,0 +1,71 @@
+package settings
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/errors.go"
+	"github.com/lbryio/lbry.go/extras/api"
+
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func updateTickerTiers(creatorChannel *model.Channel, tiers []commentapi.TickerTier) error {
+	var newTiers model.TickerTierSlice
+	for _, t := range tiers {
+		currency := strings.ToUpper(t.Currency)
+		if !currencyRegex.MatchString(currency) {
+			return api.StatusError{Err: errors.Err("'%s' is not a valid currency for a ticker tier", t.Currency), Status: http.StatusBadRequest}
+		}
+		if t.Duration == 0 {
+			return api.StatusError{Err: errors.Err("ticker tier duration must be greater than 0"), Status: http.StatusBadRequest}
+		}
+		minAmount, err := helper.ToBaseUnits(currency, t.MinAmount)
+		if err != nil {
+			return api.StatusError{Err: errors.Err(err), Status: http.StatusBadRequest}
+		}
+		newTiers = append(newTiers, &model.TickerTier{
+			CreatorChannelID: creatorChannel.ClaimID,
+			Currency:         currency,
+			MinAmount:        minAmount,
+			Duration:         t.Duration,
+		})
+	}
+
+	return db.WithTx(db.RW, nil, func(tx boil.Transactor) error {
+		err := creatorChannel.CreatorChannelTickerTiers().DeleteAll(tx)
+		if err != nil {
+			return errors.Err(err)
+		}
+		for _, t := range newTiers {
+			err = t.Insert(tx, boil.Infer())
+			if err != nil {
+				return errors.Err(err)
+			}
+		}
+		return nil
+	})
+}
+
+func applyTickerTiersToReply(creatorChannel *model.Channel, reply *commentapi.ListSettingsResponse) error {
+	tiers, err := helper.GetTickerTiers(creatorChannel.ClaimID)
+	if err != nil {
+		return err
+	}
+	for _, t := range tiers {
+		reply.TickerTiers = append(reply.TickerTiers, commentapi.TickerTier{
+			Currency:  t.Currency,
+			MinAmount: helper.FromBaseUnits(t.Currency, t.MinAmount),
+			Duration:  t.Duration,
+		})
+	}
+	return nil
+}
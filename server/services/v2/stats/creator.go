@@ -0,0 +1,221 @@
+package stats
+
+import (
+	"database/sql"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
+	"github.com/lbryio/commentron/server/apikeys"
+	"github.com/lbryio/commentron/server/lbry"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+const defaultRange = 30 * 24 * time.Hour
+
+// maxRange is the longest range that can be requested for each interval
+var maxRange = map[string]time.Duration{
+	commentapi.IntervalHour: 7 * 24 * time.Hour,
+	commentapi.IntervalDay:  366 * 24 * time.Hour,
+}
+
+// bucketExpression groups the hourly rollups into the interval
+var bucketExpression = map[string]string{
+	commentapi.IntervalHour: "bucket",
+	commentapi.IntervalDay:  "DATE(bucket)",
+}
+
+func creator(r *http.Request, args *commentapi.CreatorStatsArgs, reply *commentapi.CreatorStatsResponse) error {
+	modChannel, creatorChannel, err := helper.GetModerator(args.ModChannelID, args.ModChannelName, args.CreatorChannelID, args.CreatorChannelName, "", 0)
+	if err != nil {
+		return err
+	}
+	err = apikeys.ValidateSignature(r, apikeys.ScopeStats, modChannel.ClaimID, args.Signature, args.SigningTS, lbry.ReadOnly("stats.Creator", "", args.CreatorChannelID))
+	if err != nil {
+		return err
+	}
+
+	if args.Interval == "" {
+		args.Interval = commentapi.IntervalDay
+	}
+	until := time.Now()
+	if args.Until > 0 {
+		until = time.Unix(args.Until, 0)
+	}
+	since := until.Add(-defaultRange)
+	if args.Since > 0 {
+		since = time.Unix(args.Since, 0)
+	}
+	if !since.Before(until) {
+		return api.StatusError{Err: errors.Err("since must be before until"), Status: http.StatusBadRequest}
+	}
+	if until.Sub(since) > maxRange[args.Interval] {
+		return api.StatusError{Err: errors.Err("the range cannot be longer than %d days for the %s interval", int(maxRange[args.Interval].Hours()/24), args.Interval), Status: http.StatusBadRequest}
+	}
+
+	filters := []qm.QueryMod{
+		qm.Where("creator_channel_id = ?", creatorChannel.ClaimID),
+		qm.Where("bucket >= ?", rollup.Bucket(since)),
+		qm.Where("bucket <= ?", rollup.Bucket(until)),
+	}
+	if args.ClaimID != nil {
+		filters = append(filters, qm.Where("claim_id = ?", *args.ClaimID))
+	}
+
+	stats := newStats(args.Interval)
+	err = stats.addActivity(filters)
+	if err != nil {
+		return err
+	}
+	err = stats.addCommenters(filters)
+	if err != nil {
+		return err
+	}
+	err = stats.addReactions(filters)
+	if err != nil {
+		return err
+	}
+	err = stats.addTips(filters)
+	if err != nil {
+		return err
+	}
+
+	reply.Interval = args.Interval
+	reply.Since = since.Unix()
+	reply.Until = until.Unix()
+	reply.Buckets = stats.sorted()
+	return nil
+}
+
+type creatorStats struct {
+	expression string
+	buckets    map[int64]*commentapi.StatsBucket
+}
+
+func newStats(interval string) *creatorStats {
+	return &creatorStats{expression: bucketExpression[interval], buckets: make(map[int64]*commentapi.StatsBucket)}
+}
+
+func (c *creatorStats) bucket(t time.Time) *commentapi.StatsBucket {
+	key := t.Unix()
+	b, ok := c.buckets[key]
+	if !ok {
+		b = &commentapi.StatsBucket{Time: key}
+		c.buckets[key] = b
+	}
+	return b
+}
+
+func (c *creatorStats) sorted() []commentapi.StatsBucket {
+	buckets := make([]commentapi.StatsBucket, 0, len(c.buckets))
+	for _, b := range c.buckets {
+		buckets = append(buckets, *b)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Time < buckets[j].Time })
+	return buckets
+}
+
+func (c *creatorStats) addActivity(filters []qm.QueryMod) error {
+	query := append([]qm.QueryMod{
+		qm.Select(c.expression+" AS b", "CAST(SUM(comments) AS SIGNED)", "CAST(SUM(flagged) AS SIGNED)", "CAST(SUM(blocks) AS SIGNED)"),
+		qm.GroupBy("b")}, filters...)
+	return scan(m.CreatorStats(query...).Query.Query(db.RO))(func(rows *sql.Rows) error {
+		var t time.Time
+		var comments, flagged, blocks int64
+		err := rows.Scan(&t, &comments, &flagged, &blocks)
+		if err != nil {
+			return err
+		}
+		b := c.bucket(t)
+		b.Comments += comments
+		b.Flagged += flagged
+		b.Blocks += blocks
+		return nil
+	})
+}
+
+func (c *creatorStats) addCommenters(filters []qm.QueryMod) error {
+	query := append([]qm.QueryMod{
+		qm.Select(c.expression+" AS b", "COUNT(DISTINCT channel_id)"),
+		qm.GroupBy("b")}, filters...)
+	return scan(m.CreatorStatCommenters(query...).Query.Query(db.RO))(func(rows *sql.Rows) error {
+		var t time.Time
+		var commenters int64
+		err := rows.Scan(&t, &commenters)
+		if err != nil {
+			return err
+		}
+		c.bucket(t).UniqueCommenters = commenters
+		return nil
+	})
+}
+
+func (c *creatorStats) addReactions(filters []qm.QueryMod) error {
+	query := append([]qm.QueryMod{
+		qm.Select(c.expression+" AS b", "reaction_type", "CAST(SUM(count) AS SIGNED)"),
+		qm.GroupBy("b, reaction_type")}, filters...)
+	return scan(m.CreatorStatReactions(query...).Query.Query(db.RO))(func(rows *sql.Rows) error {
+		var t time.Time
+		var reactionType string
+		var count int64
+		err := rows.Scan(&t, &reactionType, &count)
+		if err != nil {
+			return err
+		}
+		b := c.bucket(t)
+		if b.Reactions == nil {
+			b.Reactions = make(map[string]int64)
+		}
+		b.Reactions[reactionType] += count
+		return nil
+	})
+}
+
+func (c *creatorStats) addTips(filters []qm.QueryMod) error {
+	query := append([]qm.QueryMod{
+		qm.Select(c.expression+" AS b", "currency", "CAST(SUM(amount) AS UNSIGNED)", "CAST(SUM(count) AS SIGNED)"),
+		qm.GroupBy("b, currency")}, filters...)
+	return scan(m.CreatorStatTips(query...).Query.Query(db.RO))(func(rows *sql.Rows) error {
+		var t time.Time
+		var currency string
+		var amount uint64
+		var count int64
+		err := rows.Scan(&t, &currency, &amount, &count)
+		if err != nil {
+			return err
+		}
+		b := c.bucket(t)
+		if b.HyperchatTotals == nil {
+			b.HyperchatTotals = make(map[string]float64)
+		}
+		b.HyperchatTotals[currency] += helper.FromBaseUnits(currency, amount)
+		b.Hyperchats += count
+		return nil
+	})
+}
+
+// scan runs the row function over each row of the query result
+func scan(rows *sql.Rows, err error) func(func(*sql.Rows) error) error {
+	return func(row func(*sql.Rows) error) error {
+		if err != nil {
+			return errors.Err(err)
+		}
+		defer helper.CloseRows(rows)
+		for rows.Next() {
+			err := row(rows)
+			if err != nil {
+				return errors.Err(err)
+			}
+		}
+		return errors.Err(rows.Err())
+	}
+}
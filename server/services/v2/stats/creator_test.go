@@ -0,0 +1,107 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/db/dbtest"
+	m "github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/rollup"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+const (
+	creatorID = "9cb713f01bf247a0e03170b5ed00d5161340c486"
+	claimID   = "abe3c90453fd481383acb4e3d243e2f4efd43e02"
+	otherID   = "0b66f8e5a3d1b9c1ab34f8b51f5c6f46c2a1b7d2"
+)
+
+func TestSorted(t *testing.T) {
+	stats := newStats(commentapi.IntervalHour)
+	now := rollup.Bucket(time.Now())
+	for _, hours := range []int{0, -2, -1, 0} {
+		stats.bucket(now.Add(time.Duration(hours)*time.Hour)).Comments++
+	}
+	buckets := stats.sorted()
+	if len(buckets) != 3 {
+		t.Fatalf("expected 3 buckets, got %d", len(buckets))
+	}
+	for i, expected := range []int64{1, 1, 2} {
+		if buckets[i].Time != now.Add(time.Duration(i-2)*time.Hour).Unix() || buckets[i].Comments != expected {
+			t.Errorf("bucket %d: expected %d comments at %d, got %d at %d", i, expected, now.Add(time.Duration(i-2)*time.Hour).Unix(), buckets[i].Comments, buckets[i].Time)
+		}
+	}
+}
+
+func TestCreatorStats(t *testing.T) {
+	dbtest.Init(t, m.TableNames.CreatorStat, m.TableNames.CreatorStatCommenter, m.TableNames.CreatorStatTip, m.TableNames.CreatorStatReaction)
+	now := time.Now()
+	comments := []*m.Comment{
+		{LbryClaimID: claimID, ChannelID: null.StringFrom(otherID), Timestamp: int(now.Unix())},
+		{LbryClaimID: claimID, ChannelID: null.StringFrom(otherID), Timestamp: int(now.Unix()), Amount: null.Uint64From(150000000)},
+		{LbryClaimID: claimID, ChannelID: null.StringFrom(creatorID), Timestamp: int(now.Unix()), IsFlagged: true},
+		{LbryClaimID: otherID, ChannelID: null.StringFrom(creatorID), Timestamp: int(now.Unix())},
+	}
+	for _, c := range comments {
+		c.CreatorChannelID = null.StringFrom(creatorID)
+		err := rollup.Comment(db.RW, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := rollup.Reaction(db.RW, comments[0], "like", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, claim := range []string{claimID, ""} {
+		err = rollup.Block(db.RW, creatorID, claim)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		claimID    *string
+		comments   int64
+		blocks     int64
+		commenters int64
+	}{
+		{name: "creator", comments: 3, blocks: 2, commenters: 2},
+		{name: "claim", claimID: &comments[0].LbryClaimID, comments: 2, blocks: 1, commenters: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filters := []qm.QueryMod{
+				qm.Where("creator_channel_id = ?", creatorID),
+				qm.Where("bucket >= ?", rollup.Bucket(now.Add(-time.Hour))),
+				qm.Where("bucket <= ?", rollup.Bucket(now)),
+			}
+			if test.claimID != nil {
+				filters = append(filters, qm.Where("claim_id = ?", *test.claimID))
+			}
+			stats := newStats(commentapi.IntervalHour)
+			for _, add := range []func([]qm.QueryMod) error{stats.addActivity, stats.addCommenters, stats.addReactions, stats.addTips} {
+				err := add(filters)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			buckets := stats.sorted()
+			if len(buckets) != 1 {
+				t.Fatalf("expected 1 bucket, got %d", len(buckets))
+			}
+			b := buckets[0]
+			if b.Comments != test.comments || b.Flagged != 1 || b.Blocks != test.blocks || b.UniqueCommenters != test.commenters {
+				t.Errorf("expected %d comments, 1 flagged, %d blocks and %d commenters, got %+v", test.comments, test.blocks, test.commenters, b)
+			}
+			if b.Reactions["like"] != 2 || b.Hyperchats != 1 || b.HyperchatTotals["LBC"] != 1.5 {
+				t.Errorf("expected 2 likes and a 1.5 LBC hyperchat, got %+v", b)
+			}
+		})
+	}
+}
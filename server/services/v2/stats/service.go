@@ -0,0 +1,15 @@
+package stats
+
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+)
+
+// Service is the service struct defined for the stats package for rpc service "stats.*"
+type Service struct{}
+
+// Creator returns the activity on the content of a creator over time, signed by the creator or a delegated moderator
+func (s *Service) Creator(r *http.Request, args *commentapi.CreatorStatsArgs, reply *commentapi.CreatorStatsResponse) error {
+	return creator(r, args, reply)
+}
@@ -0,0 +1,35 @@
+package webhooks
+
+import (
+	"net/http"
+
+	"github.com/lbryio/commentron/commentapi"
+)
+
+// Service is the service struct defined for the webhooks package for rpc service "webhook.*"
+type Service struct{}
+
+// Create registers a webhook for the events of the claims of a creator, or of every claim for global moderators
+func (s *Service) Create(r *http.Request, args *commentapi.CreateWebhookArgs, reply *commentapi.CreateWebhookResponse) error {
+	return create(r, args, reply)
+}
+
+// List returns the webhooks of a creator, or the global ones for global moderators
+func (s *Service) List(r *http.Request, args *commentapi.ListWebhooksArgs, reply *commentapi.ListWebhooksResponse) error {
+	return list(r, args, reply)
+}
+
+// Remove removes a webhook along with its delivery log
+func (s *Service) Remove(r *http.Request, args *commentapi.WebhookArgs, reply *commentapi.RemoveWebhookResponse) error {
+	return remove(r, args, reply)
+}
+
+// Deliveries returns the latest deliveries of events to a webhook
+func (s *Service) Deliveries(r *http.Request, args *commentapi.WebhookArgs, reply *commentapi.WebhookDeliveriesResponse) error {
+	return deliveries(r, args, reply)
+}
+
+// Test sends a ping event to a webhook right away and returns how it went
+func (s *Service) Test(r *http.Request, args *commentapi.WebhookArgs, reply *commentapi.TestWebhookResponse) error {
+	return test(r, args, reply)
+}
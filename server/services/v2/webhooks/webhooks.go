@@ -0,0 +1,216 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/model"
+	"github.com/lbryio/commentron/server/lbry"
+	hooks "github.com/lbryio/commentron/server/webhooks"
+
+	"github.com/lbryio/lbry.go/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// maxWebhooks is how many webhooks a creator, or the global moderators together, can register
+const maxWebhooks = 10
+
+// deliveriesShown is how many of the latest deliveries webhook.Deliveries returns
+const deliveriesShown = 50
+
+func create(_ *http.Request, args *commentapi.CreateWebhookArgs, reply *commentapi.CreateWebhookResponse) error {
+	digest := lbry.Call("webhook.Create", "", args.ChannelID, strconv.FormatBool(args.Global), args.URL, strings.Join(args.Events, ","))
+	channel, err := authorize(args.ChannelID, args.ChannelName, args.Signature, args.SigningTS, args.Global, digest)
+	if err != nil {
+		return err
+	}
+	owner := ownedBy(channel, args.Global)
+	registered, err := model.Webhooks(owner).Count(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if registered >= maxWebhooks {
+		return api.StatusError{Err: errors.Err("at most %d webhooks can be registered", maxWebhooks), Status: http.StatusBadRequest}
+	}
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return errors.Err(err)
+	}
+	hook := &model.Webhook{
+		URL:    args.URL,
+		Secret: hex.EncodeToString(secret),
+		Events: strings.Join(args.Events, ","),
+	}
+	if !args.Global {
+		hook.CreatorChannelID.SetValid(channel.ClaimID)
+	}
+	err = hook.Insert(db.RW, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	reply.Webhook = populateWebhook(hook)
+	reply.Secret = hook.Secret
+	return nil
+}
+
+func list(_ *http.Request, args *commentapi.ListWebhooksArgs, reply *commentapi.ListWebhooksResponse) error {
+	digest := lbry.ReadOnly("webhook.List", "", args.ChannelID, strconv.FormatBool(args.Global))
+	channel, err := authorize(args.ChannelID, args.ChannelName, args.Signature, args.SigningTS, args.Global, digest)
+	if err != nil {
+		return err
+	}
+	registered, err := model.Webhooks(ownedBy(channel, args.Global), qm.OrderBy(model.WebhookColumns.ID)).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	reply.Webhooks = make([]commentapi.Webhook, len(registered))
+	for i, hook := range registered {
+		reply.Webhooks[i] = populateWebhook(hook)
+	}
+	return nil
+}
+
+func remove(_ *http.Request, args *commentapi.WebhookArgs, reply *commentapi.RemoveWebhookResponse) error {
+	hook, err := find(args, lbry.Call("webhook.Remove", "", args.ChannelID, strconv.FormatUint(args.ID, 10)))
+	if err != nil {
+		return err
+	}
+	err = hook.Delete(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	reply.Removed = true
+	return nil
+}
+
+func deliveries(_ *http.Request, args *commentapi.WebhookArgs, reply *commentapi.WebhookDeliveriesResponse) error {
+	hook, err := find(args, lbry.ReadOnly("webhook.Deliveries", "", args.ChannelID, strconv.FormatUint(args.ID, 10)))
+	if err != nil {
+		return err
+	}
+	latest, err := hook.WebhookDeliveries(qm.OrderBy(model.WebhookDeliveryColumns.ID+" DESC"), qm.Limit(deliveriesShown)).All(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	reply.Deliveries = make([]commentapi.WebhookDelivery, len(latest))
+	for i, delivery := range latest {
+		reply.Deliveries[i] = populateDelivery(delivery)
+	}
+	return nil
+}
+
+func test(_ *http.Request, args *commentapi.WebhookArgs, reply *commentapi.TestWebhookResponse) error {
+	hook, err := find(args, lbry.Call("webhook.Test", "", args.ChannelID, strconv.FormatUint(args.ID, 10)))
+	if err != nil {
+		return err
+	}
+	delivery, err := hooks.Ping(hook)
+	if err != nil {
+		return err
+	}
+	reply.Delivery = populateDelivery(delivery)
+	return nil
+}
+
+// authorize checks the channel signed the digest of the call, and is a global moderator when managing the global
+// webhooks
+func authorize(channelID, channelName, signature, signingTS string, global bool, digest lbry.Digest) (*model.Channel, error) {
+	channel, err := helper.FindOrCreateChannel(channelID, channelName)
+	if err != nil {
+		return nil, err
+	}
+	err = lbry.ValidateSignature(channel.ClaimID, signature, signingTS, digest)
+	if err != nil {
+		return nil, err
+	}
+	if global {
+		err = checkGlobalMod(channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return channel, nil
+}
+
+func checkGlobalMod(channel *model.Channel) error {
+	isMod, err := channel.ModChannelModerators().Exists(db.RO)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if !isMod {
+		return api.StatusError{Err: errors.Err("only global moderators can manage global webhooks"), Status: http.StatusForbidden}
+	}
+	return nil
+}
+
+// find returns the webhook of the args if the channel signed the digest and is allowed to manage it
+func find(args *commentapi.WebhookArgs, digest lbry.Digest) (*model.Webhook, error) {
+	channel, err := authorize(args.ChannelID, args.ChannelName, args.Signature, args.SigningTS, false, digest)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := model.FindWebhook(db.RO, args.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, api.StatusError{Err: errors.Err("could not find webhook %d", args.ID), Status: http.StatusBadRequest}
+	}
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if hook.CreatorChannelID.Valid {
+		if hook.CreatorChannelID.String != channel.ClaimID {
+			return nil, api.StatusError{Err: errors.Err("could not find webhook %d", args.ID), Status: http.StatusBadRequest}
+		}
+		return hook, nil
+	}
+	err = checkGlobalMod(channel)
+	if err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func ownedBy(channel *model.Channel, global bool) qm.QueryMod {
+	if global {
+		return model.WebhookWhere.CreatorChannelID.IsNull()
+	}
+	return model.WebhookWhere.CreatorChannelID.EQ(null.StringFrom(channel.ClaimID))
+}
+
+func populateWebhook(hook *model.Webhook) commentapi.Webhook {
+	return commentapi.Webhook{
+		ID:        hook.ID,
+		URL:       hook.URL,
+		Events:    hooks.Events(hook),
+		Global:    !hook.CreatorChannelID.Valid,
+		CreatedAt: hook.CreatedAt.Unix(),
+	}
+}
+
+func populateDelivery(delivery *model.WebhookDelivery) commentapi.WebhookDelivery {
+	item := commentapi.WebhookDelivery{
+		ID:           delivery.ID,
+		Event:        delivery.Event,
+		Attempts:     delivery.Attempts,
+		StatusCode:   delivery.StatusCode.Int,
+		Error:        delivery.LastError.String,
+		CreatedAt:    delivery.CreatedAt.Unix(),
+		DeadLettered: delivery.DeadLetteredAt.Valid,
+	}
+	if delivery.DeliveredAt.Valid {
+		deliveredAt := delivery.DeliveredAt.Time.Unix()
+		item.DeliveredAt = &deliveredAt
+	}
+	return item
+}
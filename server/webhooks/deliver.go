@@ -0,0 +1,176 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/db"
+	"github.com/lbryio/commentron/helper"
+	"github.com/lbryio/commentron/metrics"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+const (
+	// maxAttempts is how many times an event is sent before it is dead lettered
+	maxAttempts = 8
+	// deliveryBatch is how many events are sent each time the deliveries are processed
+	deliveryBatch   = 100
+	firstRetryDelay = time.Minute
+	maxRetryDelay   = 6 * time.Hour
+	// logRetention is how long delivered and dead lettered events are kept in the delivery log
+	logRetention = 7 * 24 * time.Hour
+	// deliveryLease is how long an instance has to send an event it claimed before others can retry it
+	deliveryLease = 5 * time.Minute
+)
+
+// globalClient sends the events of the global webhooks, which global moderators can point at internal services
+var globalClient = &http.Client{Timeout: 10 * time.Second}
+
+// Deliver sends the events queued for webhooks that are due, oldest first. Failed events are retried with an
+// exponential backoff and dead lettered when they keep failing. Old entries of the delivery log are removed.
+func Deliver() error {
+	due, err := model.WebhookDeliveries(
+		model.WebhookDeliveryWhere.DeliveredAt.IsNull(),
+		model.WebhookDeliveryWhere.DeadLetteredAt.IsNull(),
+		model.WebhookDeliveryWhere.NextAttemptAt.LTE(time.Now()),
+		qm.Load(model.WebhookDeliveryRels.Webhook),
+		qm.OrderBy(model.WebhookDeliveryColumns.ID),
+		qm.Limit(deliveryBatch)).All(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, delivery := range due {
+		claimed, err := claim(delivery)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		err = attempt(delivery.R.Webhook, delivery, true)
+		if err != nil {
+			return err
+		}
+	}
+	err = model.WebhookDeliveries(
+		model.WebhookDeliveryWhere.CreatedAt.LT(time.Now().Add(-logRetention)),
+		qm.Where("("+model.WebhookDeliveryColumns.DeliveredAt+" IS NOT NULL OR "+model.WebhookDeliveryColumns.DeadLetteredAt+" IS NOT NULL)")).DeleteAll(db.RW)
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+// claim leases the due event to this instance so the other instances running the shared jobs do not send it too. The
+// lease running out lets another instance retry it if this one stops before it is done.
+func claim(delivery *model.WebhookDelivery) (bool, error) {
+	now := time.Now()
+	result, err := queries.Raw(`UPDATE `+model.TableNames.WebhookDelivery+` SET `+model.WebhookDeliveryColumns.NextAttemptAt+` = ?
+		WHERE `+model.WebhookDeliveryColumns.ID+` = ? AND `+model.WebhookDeliveryColumns.NextAttemptAt+` <= ?
+		AND `+model.WebhookDeliveryColumns.DeliveredAt+` IS NULL AND `+model.WebhookDeliveryColumns.DeadLetteredAt+` IS NULL`,
+		now.Add(deliveryLease), delivery.ID, now).Exec(db.RW)
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return rows == 1, nil
+}
+
+// Ping sends a ping event to the webhook right away. It is recorded in the delivery log of the webhook but not
+// retried if it fails.
+func Ping(hook *model.Webhook) (*model.WebhookDelivery, error) {
+	payload, err := json.Marshal(commentapi.WebhookEvent{
+		Event:     commentapi.WebhookPing,
+		Timestamp: time.Now().Unix(),
+		Data:      map[string]interface{}{"webhook_id": hook.ID},
+	})
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	// leased right away so the delivery job leaves it alone
+	delivery := &model.WebhookDelivery{WebhookID: hook.ID, Event: commentapi.WebhookPing, Payload: string(payload), NextAttemptAt: time.Now().Add(deliveryLease)}
+	err = delivery.Insert(db.RW, boil.Infer())
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	err = attempt(hook, delivery, false)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// attempt sends the event to the webhook and records how it went in the delivery log, scheduling the next attempt
+// if it failed and should be retried
+func attempt(hook *model.Webhook, delivery *model.WebhookDelivery, retry bool) error {
+	delivery.Attempts++
+	status, err := send(hook, delivery)
+	delivery.StatusCode = null.NewInt(status, status != 0)
+	if err == nil {
+		metrics.WebhookDeliveries.WithLabelValues("delivered").Inc()
+		delivery.DeliveredAt.SetValid(time.Now())
+		delivery.LastError = null.String{}
+	} else if !retry || delivery.Attempts >= maxAttempts {
+		metrics.WebhookDeliveries.WithLabelValues("dead_lettered").Inc()
+		delivery.DeadLetteredAt.SetValid(time.Now())
+		delivery.LastError.SetValid(err.Error())
+	} else {
+		metrics.WebhookDeliveries.WithLabelValues("failed").Inc()
+		delivery.NextAttemptAt = time.Now().Add(helper.Backoff(delivery.Attempts, firstRetryDelay, maxRetryDelay))
+		delivery.LastError.SetValid(err.Error())
+	}
+	err = delivery.Update(db.RW, boil.Infer())
+	if err != nil {
+		return errors.Err(err)
+	}
+	return nil
+}
+
+// send posts the event to the webhook signed with its secret. It returns the status code the webhook responded
+// with, which must be a 2xx for the event to be delivered. Creator webhooks are only sent to public addresses. The
+// errors are shown to the owner of the webhook so they do not tell why the webhook could not be reached.
+func send(hook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, hook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	timestamp := time.Now().Unix()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "commentron-webhooks")
+	request.Header.Set("X-Commentron-Event", delivery.Event)
+	request.Header.Set("X-Commentron-Delivery", strconv.FormatUint(delivery.ID, 10))
+	request.Header.Set("X-Commentron-Timestamp", strconv.FormatInt(timestamp, 10))
+	request.Header.Set("X-Commentron-Signature", Sign(hook.Secret, timestamp, []byte(delivery.Payload)))
+	client := globalClient
+	if hook.CreatorChannelID.Valid {
+		client = creatorClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		logrus.Debugf("Webhook %d: %s", hook.ID, err.Error())
+		return 0, errors.Err("could not reach the webhook")
+	}
+	defer helper.CloseBody(response.Body)
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(response.Body, 64<<10))
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, errors.Err("webhook responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
@@ -0,0 +1,119 @@
+package webhooks
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lbryio/commentron/model"
+
+	"github.com/volatiletech/null"
+)
+
+func TestSend(t *testing.T) {
+	payload := `{"event":"created","timestamp":1650000000,"data":{"comment_id":"abc"}}`
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	hook := &model.Webhook{ID: 1, URL: receiver.URL, Secret: "secret"}
+	status, err := send(hook, &model.WebhookDelivery{ID: 7, Event: "created", Payload: payload})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, status)
+	}
+	if string(body) != payload {
+		t.Errorf("expected body %s, got %s", payload, body)
+	}
+	if received.Header.Get("X-Commentron-Event") != "created" || received.Header.Get("X-Commentron-Delivery") != "7" {
+		t.Errorf("unexpected event headers %v", received.Header)
+	}
+	timestamp, err := strconv.ParseInt(received.Header.Get("X-Commentron-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.Header.Get("X-Commentron-Signature") != Sign("secret", timestamp, body) {
+		t.Error("signature does not match the body")
+	}
+	if received.Header.Get("X-Commentron-Signature") == Sign("other secret", timestamp, body) {
+		t.Error("signature matches with another secret")
+	}
+}
+
+func TestSendFailure(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	hook := &model.Webhook{ID: 1, URL: receiver.URL, Secret: "secret"}
+	status, err := send(hook, &model.WebhookDelivery{ID: 7, Event: "created", Payload: "{}"})
+	if err == nil {
+		t.Error("expected an error for a 500 response")
+	}
+	if status != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, status)
+	}
+
+	receiver.Close()
+	status, err = send(hook, &model.WebhookDelivery{ID: 8, Event: "created", Payload: "{}"})
+	if err == nil || status != 0 {
+		t.Errorf("expected an error without a status when the webhook is down, got %d %v", status, err)
+	}
+}
+
+func TestSendCreatorWebhookToInternalAddress(t *testing.T) {
+	var reached bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer receiver.Close()
+
+	hook := &model.Webhook{ID: 1, URL: receiver.URL, Secret: "secret", CreatorChannelID: null.StringFrom("9cb713f01bf247a0e03170b5ed00d5161340c486")}
+	status, err := send(hook, &model.WebhookDelivery{ID: 7, Event: "created", Payload: "{}"})
+	if err == nil || status != 0 || reached {
+		t.Fatalf("expected a creator webhook on a loopback address to be refused, got %d %v", status, err)
+	}
+	if strings.Contains(err.Error(), "127.0.0.1") {
+		t.Errorf("the error should not tell why the webhook could not be reached: %s", err.Error())
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{"1.1.1.1", true},
+		{"93.184.216.34", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"172.32.0.1", true},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+	}
+	for _, test := range tests {
+		if isPublic(net.ParseIP(test.ip)) != test.expected {
+			t.Errorf("%s: expected public to be %t", test.ip, test.expected)
+		}
+	}
+}
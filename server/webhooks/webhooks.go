@@ -0,0 +1,74 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lbryio/commentron/commentapi"
+	"github.com/lbryio/commentron/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// Publish queues the event for the webhooks of the creator of the claim it happened on and the global webhooks
+// registered for it. It should be passed the transaction of the change the event is about so it is only delivered
+// if the change is committed.
+func Publish(exec boil.Executor, event, creatorChannelID string, data interface{}) error {
+	forCreator := model.WebhookWhere.CreatorChannelID.IsNull()
+	if creatorChannelID != "" {
+		forCreator = qm.Where("("+model.WebhookColumns.CreatorChannelID+" = ? OR "+model.WebhookColumns.CreatorChannelID+" IS NULL)", creatorChannelID)
+	}
+	hooks, err := model.Webhooks(forCreator).All(exec)
+	if err != nil {
+		return errors.Err(err)
+	}
+	var payload []byte
+	for _, hook := range hooks {
+		if !subscribed(hook, event) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(commentapi.WebhookEvent{Event: event, Timestamp: time.Now().Unix(), Data: data})
+			if err != nil {
+				return errors.Err(err)
+			}
+		}
+		delivery := &model.WebhookDelivery{WebhookID: hook.ID, Event: event, Payload: string(payload), NextAttemptAt: time.Now()}
+		err = delivery.Insert(exec, boil.Infer())
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	return nil
+}
+
+// Events returns the events the webhook is registered for
+func Events(hook *model.Webhook) []string {
+	return strings.Split(hook.Events, ",")
+}
+
+func subscribed(hook *model.Webhook, event string) bool {
+	for _, e := range Events(hook) {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the signature of the body of an event sent at the unix timestamp, which receivers should compute with
+// their secret and compare to the X-Commentron-Signature header
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
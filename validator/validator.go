@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"net/url"
 	"regexp"
 
 	v "github.com/lbryio/ozzo-validation"
@@ -20,6 +21,23 @@ var (
 	ClaimID = v.NewStringRule(func(str string) bool {
 		return matchesRegex(ClaimIDRegex, str)
 	}, "Invalid claim id")
+	// ReactionNameRegex regex for the names of reaction types
+	ReactionNameRegex = `^[a-z0-9_]{1,32}$`
+	// ReactionName validator to validate a reaction type name
+	ReactionName = v.NewStringRule(func(str string) bool {
+		return matchesRegex(ReactionNameRegex, str)
+	}, "Invalid reaction type name, only lowercase letters, numbers and underscores are allowed")
+
+	// WebhookURL validator to validate the url global webhook events are sent to
+	WebhookURL = v.NewStringRule(func(str string) bool {
+		u, err := url.Parse(str)
+		return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "" && len(str) <= 2048
+	}, "Invalid webhook url, it must be an absolute http(s) url")
+	// CreatorWebhookURL validator to validate the url the webhook events of a creator are sent to
+	CreatorWebhookURL = v.NewStringRule(func(str string) bool {
+		u, err := url.Parse(str)
+		return err == nil && u.Scheme == "https" && u.Host != "" && len(str) <= 2048
+	}, "Invalid webhook url, it must be an absolute https url")
 )
 
 func matchesRegex(regex, str string) bool {